@@ -1 +1,112 @@
 package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var consoleLines int
+var consoleFollow bool
+var consoleInterval int
+
+var novaCmd = &cobra.Command{
+	Use:   "nova",
+	Short: "Compute commands",
+}
+
+var consoleLogCmd = &cobra.Command{
+	Use:   "console-log SERVER",
+	Short: "Print the console log of a server",
+	Long:  `Print the last lines of the console log of a server, with --follow new output is printed until interrupted.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if consoleInterval <= 0 {
+			return fmt.Errorf("--interval must be at least 1 second, got %d", consoleInterval)
+		}
+
+		client, err := authenticate()
+		if err != nil {
+			return err
+		}
+		compute := client.Nova()
+
+		if !consoleFollow {
+			output, err := compute.GetConsoleOutput(args[0], consoleLines)
+			if err != nil {
+				return err
+			}
+			fmt.Print(output)
+			return nil
+		}
+
+		// the whole log is fetched while following so new output can be found by comparing with the previous log
+		previous, err := compute.GetConsoleOutput(args[0], 0)
+		if err != nil {
+			return err
+		}
+		fmt.Print(lastLines(previous, consoleLines))
+
+		for {
+			time.Sleep(time.Duration(consoleInterval) * time.Second)
+			output, err := compute.GetConsoleOutput(args[0], 0)
+			if err != nil {
+				return err
+			}
+			fmt.Print(newOutput(previous, output))
+			previous = output
+		}
+	},
+}
+
+// lastLines the last n lines of output, n <= 0 returns the whole output
+func lastLines(output string, n int) string {
+	if n <= 0 {
+		return output
+	}
+	lines := strings.SplitAfter(output, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "")
+}
+
+// newOutput the part of current not printed yet. Nova caps the console log size, once the cap is reached the start
+// is cut off while output is appended, so the end of previous is searched in current. The whole log is printed
+// again when previous can not be found anymore.
+func newOutput(previous, current string) string {
+	if strings.HasPrefix(current, previous) {
+		return current[len(previous):]
+	}
+
+	anchor := lastLines(previous, 3)
+	if anchor == "" {
+		return current
+	}
+	for offset := 0; offset < len(current); {
+		i := strings.Index(current[offset:], anchor)
+		if i < 0 {
+			break
+		}
+		// the anchor lines can repeat, everything before them must be the end of previous as well
+		end := offset + i + len(anchor)
+		if strings.HasSuffix(previous, current[:end]) {
+			return current[end:]
+		}
+		offset += i + 1
+	}
+	return current
+}
+
+func init() {
+	consoleLogCmd.Flags().IntVarP(&consoleLines, "lines", "n", 50, "number of lines to print, 0 for the whole log")
+	consoleLogCmd.Flags().BoolVarP(&consoleFollow, "follow", "f", false, "keep printing new console output")
+	consoleLogCmd.Flags().IntVarP(&consoleInterval, "interval", "i", 2, "seconds between polls when following")
+	novaCmd.AddCommand(consoleLogCmd)
+	rootCmd.AddCommand(novaCmd)
+}
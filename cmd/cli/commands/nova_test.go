@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLastLines(t *testing.T) {
+	output := "boot\nkernel\nlogin:\n"
+	assert.Equal(t, output, lastLines(output, 0))
+	assert.Equal(t, output, lastLines(output, 5))
+	assert.Equal(t, "kernel\nlogin:\n", lastLines(output, 2))
+	assert.Equal(t, "login: ", lastLines("boot\nlogin: ", 1))
+	assert.Equal(t, "", lastLines("", 3))
+}
+
+func TestNewOutput(t *testing.T) {
+	assert.Equal(t, "login:\n", newOutput("boot\n", "boot\nlogin:\n"))
+	assert.Equal(t, "", newOutput("boot\n", "boot\n"))
+	// a replaced log is printed again
+	assert.Equal(t, "kernel\n", newOutput("boot\n", "kernel\n"))
+}
+
+func TestNewOutputCappedLog(t *testing.T) {
+	previous := "boot\nkernel\nok\nok\nlogin: "
+	// the start was cut off while new output was appended
+	assert.Equal(t, "root\nok\n", newOutput(previous, "nel\nok\nok\nlogin: root\nok\n"))
+	// the repeated lines at the end of previous also appear in the new output
+	assert.Equal(t, "\nok\nok\nlogin: ", newOutput(previous, "ok\nok\nlogin: \nok\nok\nlogin: "))
+	// nothing new
+	assert.Equal(t, "", newOutput(previous, "ok\nok\nlogin: "))
+}
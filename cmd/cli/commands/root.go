@@ -3,7 +3,9 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/Buni/openstack-client/openstack"
 	"github.com/spf13/cobra"
 )
 
@@ -23,3 +25,46 @@ func Execute() {
 		os.Exit(1)
 	}
 }
+
+// authenticate build a client from the usual OS_* environment variables, OS_TOKEN takes precedence over the password.
+// Password auth is scoped to the project named after the user, with OS_PROJECT_ID the token is rescoped to that project.
+func authenticate() (openstack.Openstack, error) {
+	endpoint := os.Getenv("OS_AUTH_URL")
+	if endpoint == "" {
+		return nil, fmt.Errorf("OS_AUTH_URL is not set")
+	}
+	if !strings.HasSuffix(endpoint, "/auth/tokens") {
+		endpoint = strings.TrimSuffix(endpoint, "/") + "/auth/tokens"
+	}
+
+	projectID := os.Getenv("OS_PROJECT_ID")
+	if token := os.Getenv("OS_TOKEN"); token != "" {
+		client := openstack.NewClientFromToken(token, projectID, endpoint)
+		return client, client.Authenticate()
+	}
+
+	username := os.Getenv("OS_USERNAME")
+	if projectName := os.Getenv("OS_PROJECT_NAME"); projectID == "" && projectName != "" && projectName != username {
+		return nil, fmt.Errorf("OS_PROJECT_NAME %s is not supported with password auth, set OS_PROJECT_ID instead", projectName)
+	}
+
+	domain := os.Getenv("OS_USER_DOMAIN_ID")
+	if domain == "" {
+		domain = "default"
+	}
+	client := openstack.NewClient(openstack.AuthOptions{
+		Methods:    []string{"password"},
+		TenantName: username,
+		Domain:     domain,
+		Password:   os.Getenv("OS_PASSWORD"),
+		Endpoint:   endpoint,
+	})
+	if err := client.Authenticate(); err != nil {
+		return nil, err
+	}
+
+	if projectID == "" {
+		return client, nil
+	}
+	return client.ForProject(projectID)
+}
@@ -1,6 +1,6 @@
 package main
 
-import "github.com/Buni/openstack-client/cmd/openstack-cli/commands"
+import "github.com/Buni/openstack-client/cmd/cli/commands"
 
 func main() {
 	commands.Execute()
@@ -1,10 +1,15 @@
 package cinder
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Buni/openstack-client/openstack/client"
 	log "github.com/sirupsen/logrus"
@@ -18,9 +23,26 @@ type cinder struct {
 // Cinder interface
 type Cinder interface {
 	GetVolume(volumeID string) (volume Volume, err error)
+	CreateVolume(opts VolumeOpts) (volume Volume, err error)
+	DeleteVolume(volumeID string) (err error)
+	WaitForVolumeStatus(volumeID, status string, timeout time.Duration) (volume Volume, err error)
+	UploadVolumeToImage(volumeID string, opts UploadImageOpts) (image VolumeImage, err error)
+	ListMessages(opts ListMessagesOpts) (messages []Message, err error)
+	GetMessage(messageID string) (message Message, err error)
+	DeleteMessage(messageID string) (err error)
 }
 
+const volumesPath = "/volumes"
 const volumePath = "/volumes/$id"
+const volumeActionPath = "/volumes/$id/action"
+const messagesPath = "/messages"
+const messagePath = "/messages/$id"
+
+const apiVersionHeader = "OpenStack-API-Version"
+const messagesMicroversion = "volume 3.3"     // user messages were added in 3.3
+const listMessagesMicroversion = "volume 3.5" // message filters, sorting and pagination were added in 3.5
+
+const pollInterval = 2 * time.Second
 
 // New Cinder
 func New(authClient client.Client) Cinder {
@@ -51,3 +73,172 @@ func (c *cinder) GetVolume(volumeID string) (volume Volume, err error) {
 
 	return
 }
+
+// CreateVolume create a volume, it is usable once WaitForVolumeStatus returns available
+func (c *cinder) CreateVolume(opts VolumeOpts) (volume Volume, err error) {
+	path := c.Client.GetEndpoint("cinderv3") + volumesPath
+
+	payload, err := json.Marshal(volumeRequest{Volume: opts})
+	if err != nil {
+		return
+	}
+
+	err = c.do(c.Client.NewRequest(path, "POST", bytes.NewBuffer(payload)).MetaData("cinder", "CreateVolume"), &volume)
+	return
+}
+
+// DeleteVolume delete an available or errored volume
+func (c *cinder) DeleteVolume(volumeID string) (err error) {
+	path := c.Client.GetEndpoint("cinderv3") + volumePath
+	path = strings.Replace(path, "$id", volumeID, -1)
+
+	return c.do(c.Client.NewRequest(path, "DELETE", nil).MetaData("cinder", "DeleteVolume"), nil)
+}
+
+// WaitForVolumeStatus poll the volume until it reaches status, a volume going to error returns the latest user message
+func (c *cinder) WaitForVolumeStatus(volumeID, status string, timeout time.Duration) (volume Volume, err error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		volume, err = c.GetVolume(volumeID)
+		if err != nil {
+			return
+		}
+
+		current := volume.Volume.Status
+		if current == status {
+			return
+		}
+		if strings.HasPrefix(current, "error") {
+			err = fmt.Errorf("cinder: volume %s went to %s while waiting for %s", volumeID, current, status)
+			// servers without message filters return the messages of every resource
+			messages, _ := c.ListMessages(ListMessagesOpts{ResourceUUID: volumeID, Sort: "created_at:desc"})
+			for _, message := range messages {
+				if message.ResourceUUID == volumeID {
+					err = message
+					break
+				}
+			}
+			return
+		}
+
+		if time.Now().Add(pollInterval).After(deadline) {
+			err = fmt.Errorf("cinder: timeout waiting for volume %s to become %s, last status %s", volumeID, status, current)
+			return
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// UploadVolumeToImage uploads the volume to glance, the returned ImageID can be used to wait for the image to become active
+func (c *cinder) UploadVolumeToImage(volumeID string, opts UploadImageOpts) (image VolumeImage, err error) {
+	path := c.Client.GetEndpoint("cinderv3") + volumeActionPath
+	path = strings.Replace(path, "$id", volumeID, -1)
+
+	payload, err := json.Marshal(uploadImageRequest{UploadImage: opts})
+	if err != nil {
+		return
+	}
+
+	var jsonResponse uploadImageResponse
+	err = c.do(c.Client.NewRequest(path, "POST", bytes.NewBuffer(payload)).MetaData("cinder", "UploadVolumeToImage"), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	image = jsonResponse.UploadImage
+	return
+}
+
+// ListMessages lists user messages following the next links of every page, use ResourceUUID to get the failure
+// reasons of a single volume
+func (c *cinder) ListMessages(opts ListMessagesOpts) (messages []Message, err error) {
+	path := c.Client.GetEndpoint("cinderv3") + messagesPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse messagesResponse
+		err = c.do(c.Client.NewRequest(path, "GET", nil).Header(apiVersionHeader, listMessagesMicroversion).MetaData("cinder", "ListMessages"), &jsonResponse)
+		if err != nil {
+			return
+		}
+		messages = append(messages, jsonResponse.Messages...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetMessage get a single user message
+func (c *cinder) GetMessage(messageID string) (message Message, err error) {
+	path := c.Client.GetEndpoint("cinderv3") + messagePath
+	path = strings.Replace(path, "$id", messageID, -1)
+
+	var jsonResponse messageResponse
+	err = c.do(c.Client.NewRequest(path, "GET", nil).Header(apiVersionHeader, messagesMicroversion).MetaData("cinder", "GetMessage"), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	message = jsonResponse.Message
+	return
+}
+
+// DeleteMessage delete a single user message
+func (c *cinder) DeleteMessage(messageID string) (err error) {
+	path := c.Client.GetEndpoint("cinderv3") + messagePath
+	path = strings.Replace(path, "$id", messageID, -1)
+
+	return c.do(c.Client.NewRequest(path, "DELETE", nil).Header(apiVersionHeader, messagesMicroversion).MetaData("cinder", "DeleteMessage"), nil)
+}
+
+// do execute the request and unmarshal the response body into out, out can be nil for requests without a body
+func (c *cinder) do(req *client.Request, out interface{}) (err error) {
+	resp, err := req.Context(context.TODO()).Do()
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+	log.Debugln(string(respBody))
+
+	if out == nil || len(respBody) == 0 {
+		return
+	}
+
+	return json.Unmarshal(respBody, out)
+}
+
+func (o ListMessagesOpts) query() string {
+	query := url.Values{}
+	if o.ResourceUUID != "" {
+		query.Set("resource_uuid", o.ResourceUUID)
+	}
+	if o.ResourceType != "" {
+		query.Set("resource_type", o.ResourceType)
+	}
+	if o.EventID != "" {
+		query.Set("event_id", o.EventID)
+	}
+	if o.RequestID != "" {
+		query.Set("request_id", o.RequestID)
+	}
+	if o.MessageLevel != "" {
+		query.Set("message_level", o.MessageLevel)
+	}
+	if o.Sort != "" {
+		query.Set("sort", o.Sort)
+	}
+	if o.Marker != "" {
+		query.Set("marker", o.Marker)
+	}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return query.Encode()
+}
@@ -5,10 +5,43 @@ type Volume struct {
 	Volume volume `json:"volume"`
 }
 type volume struct {
+	ID                  string              `json:"id"`
+	Name                string              `json:"name"`
+	Size                int                 `json:"size"`
 	Status              string              `json:"status"`
+	VolumeType          string              `json:"volume_type"`
+	AvailabilityZone    string              `json:"availability_zone"`
+	Bootable            string              `json:"bootable"`
+	Attachments         []Attachment        `json:"attachments"`
 	VolumeImageMetadata volumeImageMetadata `json:"volume_image_metadata"`
 }
 
+// Attachment server a volume is attached to
+type Attachment struct {
+	ID           string `json:"id"`
+	AttachmentID string `json:"attachment_id"`
+	ServerID     string `json:"server_id"`
+	Device       string `json:"device"`
+	HostName     string `json:"host_name"`
+}
+
+// VolumeOpts create volume fields, set one of ImageRef, SnapshotID or SourceVolID to create from a source
+type VolumeOpts struct {
+	Size             int               `json:"size,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	VolumeType       string            `json:"volume_type,omitempty"`
+	AvailabilityZone string            `json:"availability_zone,omitempty"`
+	ImageRef         string            `json:"imageRef,omitempty"`
+	SnapshotID       string            `json:"snapshot_id,omitempty"`
+	SourceVolID      string            `json:"source_volid,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+}
+
+type volumeRequest struct {
+	Volume VolumeOpts `json:"volume"`
+}
+
 type volumeImageMetadata struct {
 	Checksum          string `json:"checksum"`
 	MinRAM            string `json:"min_ram"`
@@ -20,3 +53,91 @@ type volumeImageMetadata struct {
 	MinDisk           string `json:"min_disk"`
 	Size              string `json:"size"`
 }
+
+// UploadImageOpts os-volume_upload_image options
+type UploadImageOpts struct {
+	ImageName       string `json:"image_name"`
+	Force           bool   `json:"force,omitempty"`
+	DiskFormat      string `json:"disk_format,omitempty"`
+	ContainerFormat string `json:"container_format,omitempty"`
+	Visibility      string `json:"visibility,omitempty"`
+	Protected       bool   `json:"protected,omitempty"`
+}
+
+type uploadImageRequest struct {
+	UploadImage UploadImageOpts `json:"os-volume_upload_image"`
+}
+
+type uploadImageResponse struct {
+	UploadImage VolumeImage `json:"os-volume_upload_image"`
+}
+
+// VolumeImage the image being created from a volume
+type VolumeImage struct {
+	ID              string `json:"id"`
+	ImageID         string `json:"image_id"`
+	ImageName       string `json:"image_name"`
+	Status          string `json:"status"`
+	Size            int    `json:"size"`
+	DiskFormat      string `json:"disk_format"`
+	ContainerFormat string `json:"container_format"`
+	Visibility      string `json:"visibility"`
+	Protected       bool   `json:"protected"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+// ListMessagesOpts user messages filters, Limit is the page size, all pages are always listed
+type ListMessagesOpts struct {
+	ResourceUUID string
+	ResourceType string
+	EventID      string
+	RequestID    string
+	MessageLevel string
+	Sort         string
+	Marker       string
+	Limit        int
+}
+
+type messagesResponse struct {
+	Messages []Message `json:"messages"`
+	Links    links     `json:"messages_links"`
+}
+
+// Link resource link, Rel is self, bookmark or next
+type Link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+type links []Link
+
+// next href of the next page or empty on the last page
+func (l links) next() string {
+	for _, link := range l {
+		if link.Rel == "next" {
+			return link.Href
+		}
+	}
+	return ""
+}
+
+type messageResponse struct {
+	Message Message `json:"message"`
+}
+
+// Message cinder user message, implements error so failures can be returned as is
+type Message struct {
+	ID              string `json:"id"`
+	EventID         string `json:"event_id"`
+	UserMessage     string `json:"user_message"`
+	MessageLevel    string `json:"message_level"`
+	ResourceType    string `json:"resource_type"`
+	ResourceUUID    string `json:"resource_uuid"`
+	RequestID       string `json:"request_id"`
+	CreatedAt       string `json:"created_at"`
+	GuaranteedUntil string `json:"guaranteed_until"`
+}
+
+func (m Message) Error() string {
+	return m.EventID + " " + m.ResourceType + " " + m.ResourceUUID + ": " + m.UserMessage
+}
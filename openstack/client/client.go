@@ -22,6 +22,23 @@ type client struct {
 	mux        *sync.Mutex
 }
 
+// ResponseError is returned for every response with a status code above 299
+type ResponseError struct {
+	StatusCode int
+	Body       string
+	Header     http.Header
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("Code %v  %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is a 404 response, useful for HEAD checks
+func IsNotFound(err error) bool {
+	respErr, ok := err.(*ResponseError)
+	return ok && respErr.StatusCode == http.StatusNotFound
+}
+
 // Keystone import cycle prevention
 type Keystone interface {
 	Authenticate() (err error)
@@ -60,11 +77,12 @@ func (c *client) DoAuthRequest(ctx context.Context, method, url string, body io.
 		return
 	}
 
-	r := retrier.New(retrier.ConstantBackoff(c.maxRetries, timeoutBetweenRetires), nil) // TODO: Setup a Whitelist Classifier
+	r := retrier.New(retrier.ConstantBackoff(c.maxRetries, timeoutBetweenRetires), clientErrors{})
 	rq.Header.Set(authHeader, c.Keystone.GetToken())
 	rtr := 0
 
 	err = r.Run(func() (err error) {
+		rewind(rq)
 		req := rq // copy the original request to split the retry spans
 		req = req.WithContext(ctx)
 		req, ht := nethttp.TraceRequest(opentracing.GlobalTracer(), req, nethttp.ComponentName(url), nethttp.OperationName(method))
@@ -87,10 +105,11 @@ func (c *client) DoRequest(ctx context.Context, method, url string, body io.Read
 		return
 	}
 
-	r := retrier.New(retrier.ConstantBackoff(c.maxRetries, timeoutBetweenRetires), nil) // TODO: Setup a Whitelist Classifier
+	r := retrier.New(retrier.ConstantBackoff(c.maxRetries, timeoutBetweenRetires), clientErrors{})
 	rtr := 0
 
 	err = r.Run(func() (err error) {
+		rewind(rq)
 		req := rq // copy the original request to split the retry spans
 		req = req.WithContext(ctx)
 		req, ht := nethttp.TraceRequest(opentracing.GlobalTracer(), req, nethttp.ComponentName(url), nethttp.OperationName(method))
@@ -105,6 +124,18 @@ func (c *client) DoRequest(ctx context.Context, method, url string, body io.Read
 	return
 }
 
+// rewind reset the request body so retries don't send an already consumed body
+func rewind(req *http.Request) {
+	if req.GetBody == nil {
+		return
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return
+	}
+	req.Body = body
+}
+
 func (c *client) verifyAuth(req *http.Request, resp *http.Response, err error) error {
 	switch {
 	case err != nil:
@@ -124,13 +155,28 @@ func (c *client) verifyAuth(req *http.Request, resp *http.Response, err error) e
 			return err
 		}
 		resp.Body.Close()
-		return fmt.Errorf("Code %v  %s", resp.StatusCode, string(respBody))
+		return &ResponseError{StatusCode: resp.StatusCode, Body: string(respBody), Header: resp.Header}
 	case resp.StatusCode < 299:
 		log.Debugln(resp.StatusCode)
 	}
 	return err
 }
 
+// clientErrors fails 4xx responses without retrying, resending a rejected password, a request answered with an
+// auth receipt or a missing resource would only get the same answer. Transport errors and 5xx responses are retried,
+// so are 401 and 403 on authenticated requests which verifyAuth turns into plain errors after reauthenticating.
+type clientErrors struct{}
+
+func (clientErrors) Classify(err error) retrier.Action {
+	if err == nil {
+		return retrier.Succeed
+	}
+	if respErr, ok := err.(*ResponseError); ok && respErr.StatusCode < 500 {
+		return retrier.Fail
+	}
+	return retrier.Retry
+}
+
 func (c *client) verifyNoAuth(req *http.Request, resp *http.Response, err error) error {
 	switch {
 	case err != nil:
@@ -141,7 +187,7 @@ func (c *client) verifyNoAuth(req *http.Request, resp *http.Response, err error)
 			return err
 		}
 		resp.Body.Close()
-		return fmt.Errorf("Code %v  %s", resp.StatusCode, string(respBody))
+		return &ResponseError{StatusCode: resp.StatusCode, Body: string(respBody), Header: resp.Header}
 	case resp.StatusCode < 299:
 		log.Debugln(resp.StatusCode)
 	}
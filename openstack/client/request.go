@@ -40,6 +40,7 @@ type request struct {
 	query      string
 	body       io.Reader
 	authHeader string
+	headers    map[string]string
 }
 
 // Request public type
@@ -65,7 +66,7 @@ func (c *client) NewRequest(url, method string, body io.Reader) *Request {
 	log.Debugln(&clientCopy.mux, &c.mux, "mux")
 	log.Debugln(&clientCopy.HTTPClient, &c.HTTPClient, "http client struct")
 	log.Debugln(&clientCopy.HTTPClient.Transport, &c.HTTPClient.Transport, "transport ")
-	return &Request{reqClient: clientCopy, clientOptions: options{MaxRetries: maxRetries, TimeoutBetweenRetries: timeoutBetweenRetires, ReqTimeout: timeout}, reqOptions: request{url: url, method: method, body: body, ctx: context.TODO(), authHeader: authHeader}, mux: new(sync.Mutex)}
+	return &Request{reqClient: clientCopy, clientOptions: options{MaxRetries: c.maxRetries, TimeoutBetweenRetries: timeoutBetweenRetires, ReqTimeout: timeout}, reqOptions: request{url: url, method: method, body: body, ctx: context.TODO(), authHeader: authHeader, headers: make(map[string]string)}, mux: new(sync.Mutex)}
 }
 
 // Context add context.Context to request for tracing purposes
@@ -84,6 +85,14 @@ func (r *Request) AuthHeader(name string) *Request {
 	return r
 }
 
+// Header add an extra request header e.g. OpenStack-API-Version
+func (r *Request) Header(key, value string) *Request {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.reqOptions.headers[key] = value
+	return r
+}
+
 // QueryKV add query key value pair
 func (r *Request) QueryKV(key, value string) *Request {
 	r.mux.Lock()
@@ -167,13 +176,19 @@ func (r *Request) Do() (resp *http.Response, err error) {
 	}
 
 	rq.Header.Set("Content-Type", "application/json") // Fix later
+	for key, value := range r.reqOptions.headers {
+		rq.Header.Set(key, value)
+	}
 
-	log.Debugln(rq)
-	retry := retrier.New(retrier.ConstantBackoff(maxRetries, timeoutBetweenRetires), nil) // TODO: Setup a Whitelist Classifier
+	// the body is not logged, it can hold passwords and credential secrets
+	log.Debugln(rq.Method, rq.URL)
+
+	retry := retrier.New(retrier.ConstantBackoff(r.clientOptions.MaxRetries, r.clientOptions.TimeoutBetweenRetries), clientErrors{})
 	rq.Header.Set(r.reqOptions.authHeader, r.reqClient.GetToken())
 	rtr := 0
 
 	err = retry.Run(func() (err error) {
+		rewind(rq)
 		req := rq
 		req = req.WithContext(r.reqOptions.ctx)
 		req, ht := nethttp.TraceRequest(opentracing.GlobalTracer(), req, nethttp.ComponentName(r.reqMetaData.ComponentName), nethttp.OperationName(r.reqMetaData.OperationName))
@@ -194,10 +209,14 @@ func (r *Request) DoNonAuth() (resp *http.Response, err error) {
 	if err != nil {
 		return
 	}
-	retry := retrier.New(retrier.ConstantBackoff(maxRetries, timeoutBetweenRetires), nil) // TODO: Setup a Whitelist Classifier
+	for key, value := range r.reqOptions.headers {
+		rq.Header.Set(key, value)
+	}
+	retry := retrier.New(retrier.ConstantBackoff(r.clientOptions.MaxRetries, r.clientOptions.TimeoutBetweenRetries), clientErrors{})
 
 	rtr := 0
 	err = retry.Run(func() (err error) {
+		rewind(rq)
 		req := rq
 		req = req.WithContext(context.TODO())
 		req, ht := nethttp.TraceRequest(opentracing.GlobalTracer(), req, nethttp.ComponentName(r.reqMetaData.ComponentName), nethttp.OperationName(r.reqMetaData.OperationName))
@@ -0,0 +1,72 @@
+package keystone
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const applicationCredentialsPath = "/users/$id/application_credentials"
+const applicationCredentialPath = "/users/$id/application_credentials/$credential"
+
+// NewApplicationCredential instance of keystone authenticating with an application credential id and secret
+func NewApplicationCredential(credentialID, secret, endpoint string) Keystone {
+	auth := authScoped{Identity: identity{Methods: []string{"application_credential"}, ApplicationCredential: &applicationCredentialMethod{ID: credentialID, Secret: secret}}}
+	return newClient(&keystone{Auth: auth, Token: token{}, Endpoint: endpoint, Endpoints: make(map[string]string), mux: new(sync.Mutex)})
+}
+
+// ListApplicationCredentials list the user application credentials, filtered by name when set
+func (k *keystone) ListApplicationCredentials(userID, name string) (credentials []ApplicationCredential, err error) {
+	path := k.identityURL() + strings.Replace(applicationCredentialsPath, "$id", userID, -1)
+	if name != "" {
+		path += "?name=" + url.QueryEscape(name)
+	}
+
+	for path != "" {
+		var jsonResponse ApplicationCredentialResponse
+		err = k.do("GET", path, "ListApplicationCredentials", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		credentials = append(credentials, jsonResponse.ApplicationCredentials...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetApplicationCredential get application credential by id, the secret is not returned
+func (k *keystone) GetApplicationCredential(userID, credentialID string) (credential ApplicationCredential, err error) {
+	var jsonResponse applicationCredentialResponse
+	err = k.do("GET", k.applicationCredentialPath(userID, credentialID), "GetApplicationCredential", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	credential = jsonResponse.ApplicationCredential
+	return
+}
+
+// CreateApplicationCredential create an application credential for the current project, keep the returned Secret it can't be retrieved again
+func (k *keystone) CreateApplicationCredential(userID string, opts ApplicationCredentialOpts) (credential ApplicationCredential, err error) {
+	path := k.identityURL() + strings.Replace(applicationCredentialsPath, "$id", userID, -1)
+
+	var jsonResponse applicationCredentialResponse
+	err = k.do("POST", path, "CreateApplicationCredential", applicationCredentialRequest{ApplicationCredential: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	credential = jsonResponse.ApplicationCredential
+	return
+}
+
+// DeleteApplicationCredential delete application credential by id
+func (k *keystone) DeleteApplicationCredential(userID, credentialID string) (err error) {
+	return k.do("DELETE", k.applicationCredentialPath(userID, credentialID), "DeleteApplicationCredential", nil, nil)
+}
+
+func (k *keystone) applicationCredentialPath(userID, credentialID string) string {
+	path := strings.Replace(applicationCredentialPath, "$id", userID, -1)
+	return k.identityURL() + strings.Replace(path, "$credential", credentialID, -1)
+}
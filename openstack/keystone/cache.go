@@ -0,0 +1,170 @@
+package keystone
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// cacheExpiryMargin cached tokens expiring sooner than this are not restored
+const cacheExpiryMargin = time.Minute * 5
+
+// CachedToken everything needed to restore an authenticated keystone without calling keystone
+type CachedToken struct {
+	Value     string            `json:"value"`
+	ExpiresAt time.Time         `json:"expires_at"`
+	ProjectID string            `json:"project_id"`
+	Endpoints map[string]string `json:"endpoints"`
+}
+
+// TokenCache token store shared between processes, Get returns ok false on a miss
+type TokenCache interface {
+	Get(key string) (cached CachedToken, ok bool, err error)
+	Set(key string, cached CachedToken) (err error)
+}
+
+type fileCache struct {
+	dir string
+}
+
+// NewFileCache token cache storing one file per key in dir, the directory and files are only accessible by the current user,
+// dir is created with mode 0700 and an existing dir accessible by other users is refused
+func NewFileCache(dir string) TokenCache {
+	return &fileCache{dir: dir}
+}
+
+func (f *fileCache) Get(key string) (cached CachedToken, ok bool, err error) {
+	content, err := ioutil.ReadFile(f.path(key))
+	if os.IsNotExist(err) {
+		return cached, false, nil
+	}
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(content, &cached)
+	if err != nil {
+		return
+	}
+
+	return cached, true, nil
+}
+
+// Set write to a temporary file first so concurrent readers never see a partial token
+func (f *fileCache) Set(key string, cached CachedToken) (err error) {
+	info, err := os.Stat(f.dir)
+	if os.IsNotExist(err) {
+		err = os.MkdirAll(f.dir, 0700)
+	} else if err == nil && info.Mode().Perm()&0077 != 0 {
+		// an existing directory is never changed, it may be shared like /tmp or $HOME
+		err = fmt.Errorf("keystone: token cache directory %s is accessible by other users, use a directory with mode 0700", f.dir)
+	}
+	if err != nil {
+		return
+	}
+
+	content, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+
+	tmp, err := ioutil.TempFile(f.dir, ".token-")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return
+	}
+
+	err = os.Chmod(tmp.Name(), 0600)
+	if err != nil {
+		return
+	}
+
+	return os.Rename(tmp.Name(), f.path(key))
+}
+
+func (f *fileCache) path(key string) string {
+	return filepath.Join(f.dir, key+".json")
+}
+
+// cacheKey identifies the auth url, user and scope without including any secret
+func (k *keystone) cacheKey() string {
+	auth := k.Auth
+	auth.Identity.TOTP = nil
+	if auth.Identity.Password != nil {
+		withoutSecret := *auth.Identity.Password
+		withoutSecret.User.Password = ""
+		auth.Identity.Password = &withoutSecret
+	}
+	if auth.Identity.ApplicationCredential != nil {
+		auth.Identity.ApplicationCredential = &applicationCredentialMethod{ID: auth.Identity.ApplicationCredential.ID}
+	}
+
+	key := struct {
+		Endpoint string      `json:"endpoint"`
+		Auth     authScoped  `json:"auth"`
+		EC2      string      `json:"ec2,omitempty"`
+		OIDC     interface{} `json:"oidc,omitempty"`
+	}{Endpoint: k.Endpoint, Auth: auth}
+
+	if k.ec2 != nil {
+		key.EC2 = k.ec2.access
+	}
+	if k.oidc != nil {
+		// federated logins replace the identity with the token method, only the idp user identifies them
+		key.Auth.Identity = identity{}
+		key.OIDC = []string{k.oidc.AuthType, k.oidc.IdentityProvider, k.oidc.Protocol, k.oidc.ClientID, k.oidc.Username}
+	}
+
+	content, _ := json.Marshal(key)
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// restoreToken use a still valid cached token, expects k.mux to be held
+func (k *keystone) restoreToken() (ok bool) {
+	cached, ok, err := k.cache.Get(k.cacheKey())
+	if err != nil {
+		log.Warnln("token cache read failed", err)
+		return false
+	}
+	if !ok || cached.Value == "" || time.Until(cached.ExpiresAt) < cacheExpiryMargin {
+		return false
+	}
+
+	k.Token.Value = cached.Value
+	k.Token.ExperiesAt = cached.ExpiresAt
+	k.Token.ProjectID = cached.ProjectID
+	for name, url := range cached.Endpoints {
+		k.Endpoints[name] = url
+	}
+
+	return true
+}
+
+// storeToken save the current token, failing to cache does not fail the authentication
+func (k *keystone) storeToken() {
+	endpoints := make(map[string]string, len(k.Endpoints))
+	for name, url := range k.Endpoints {
+		endpoints[name] = url
+	}
+
+	err := k.cache.Set(k.cacheKey(), CachedToken{Value: k.Token.Value, ExpiresAt: k.Token.ExperiesAt, ProjectID: k.Token.ProjectID, Endpoints: endpoints})
+	if err != nil {
+		log.Warnln("token cache write failed", err)
+	}
+}
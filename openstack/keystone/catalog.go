@@ -0,0 +1,231 @@
+package keystone
+
+import (
+	"net/url"
+	"strings"
+)
+
+const regionsPath = "/regions"
+const regionPath = "/regions/$id"
+const servicesPath = "/services"
+const servicePath = "/services/$id"
+const endpointsPath = "/endpoints"
+const endpointPath = "/endpoints/$id"
+
+// ListRegions list all regions or only the children of parentRegionID when set
+func (k *keystone) ListRegions(parentRegionID string) (regions []Region, err error) {
+	path := k.identityURL() + regionsPath
+	if parentRegionID != "" {
+		path += "?parent_region_id=" + url.QueryEscape(parentRegionID)
+	}
+
+	for path != "" {
+		var jsonResponse RegionResponse
+		err = k.do("GET", path, "ListRegions", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		regions = append(regions, jsonResponse.Regions...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetRegion get region by id
+func (k *keystone) GetRegion(regionID string) (region Region, err error) {
+	path := k.identityURL() + strings.Replace(regionPath, "$id", regionID, -1)
+
+	var jsonResponse regionResponse
+	err = k.do("GET", path, "GetRegion", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	region = jsonResponse.Region
+	return
+}
+
+// CreateRegion create a region, with an ID the region is created with PUT so the call is idempotent
+func (k *keystone) CreateRegion(opts RegionOpts) (region Region, err error) {
+	method, path := "POST", k.identityURL()+regionsPath
+	if opts.ID != "" {
+		method, path = "PUT", k.identityURL()+strings.Replace(regionPath, "$id", opts.ID, -1)
+	}
+
+	var jsonResponse regionResponse
+	err = k.do(method, path, "CreateRegion", regionRequest{Region: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	region = jsonResponse.Region
+	return
+}
+
+// UpdateRegion update the description or parent region
+func (k *keystone) UpdateRegion(regionID string, opts RegionOpts) (region Region, err error) {
+	path := k.identityURL() + strings.Replace(regionPath, "$id", regionID, -1)
+	opts.ID = ""
+
+	var jsonResponse regionResponse
+	err = k.do("PATCH", path, "UpdateRegion", regionRequest{Region: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	region = jsonResponse.Region
+	return
+}
+
+// DeleteRegion delete region by id
+func (k *keystone) DeleteRegion(regionID string) (err error) {
+	path := k.identityURL() + strings.Replace(regionPath, "$id", regionID, -1)
+	return k.do("DELETE", path, "DeleteRegion", nil, nil)
+}
+
+// ListServices list all services or only the ones of serviceType when set
+func (k *keystone) ListServices(serviceType string) (services []Service, err error) {
+	path := k.identityURL() + servicesPath
+	if serviceType != "" {
+		path += "?type=" + url.QueryEscape(serviceType)
+	}
+
+	for path != "" {
+		var jsonResponse ServiceResponse
+		err = k.do("GET", path, "ListServices", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		services = append(services, jsonResponse.Services...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetService get service by id
+func (k *keystone) GetService(serviceID string) (service Service, err error) {
+	path := k.identityURL() + strings.Replace(servicePath, "$id", serviceID, -1)
+
+	var jsonResponse serviceResponse
+	err = k.do("GET", path, "GetService", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	service = jsonResponse.Service
+	return
+}
+
+// CreateService create a catalog service
+func (k *keystone) CreateService(opts ServiceOpts) (service Service, err error) {
+	path := k.identityURL() + servicesPath
+
+	var jsonResponse serviceResponse
+	err = k.do("POST", path, "CreateService", serviceRequest{Service: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	service = jsonResponse.Service
+	return
+}
+
+// UpdateService update only the fields set in opts
+func (k *keystone) UpdateService(serviceID string, opts ServiceOpts) (service Service, err error) {
+	path := k.identityURL() + strings.Replace(servicePath, "$id", serviceID, -1)
+
+	var jsonResponse serviceResponse
+	err = k.do("PATCH", path, "UpdateService", serviceRequest{Service: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	service = jsonResponse.Service
+	return
+}
+
+// DeleteService delete service by id
+func (k *keystone) DeleteService(serviceID string) (err error) {
+	path := k.identityURL() + strings.Replace(servicePath, "$id", serviceID, -1)
+	return k.do("DELETE", path, "DeleteService", nil, nil)
+}
+
+// ListEndpoints list endpoints matching opts
+func (k *keystone) ListEndpoints(opts ListEndpointsOpts) (endpoints []Endpoint, err error) {
+	path := k.identityURL() + endpointsPath
+	query := url.Values{}
+	if opts.Interface != "" {
+		query.Set("interface", opts.Interface)
+	}
+	if opts.RegionID != "" {
+		query.Set("region_id", opts.RegionID)
+	}
+	if opts.ServiceID != "" {
+		query.Set("service_id", opts.ServiceID)
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	for path != "" {
+		var jsonResponse EndpointResponse
+		err = k.do("GET", path, "ListEndpoints", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		endpoints = append(endpoints, jsonResponse.Endpoints...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetEndpointByID get endpoint by id, use GetEndpoint for the public url of a catalog service
+func (k *keystone) GetEndpointByID(endpointID string) (endpoint Endpoint, err error) {
+	path := k.identityURL() + strings.Replace(endpointPath, "$id", endpointID, -1)
+
+	var jsonResponse endpointResponse
+	err = k.do("GET", path, "GetEndpointByID", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	endpoint = jsonResponse.Endpoint
+	return
+}
+
+// CreateEndpoint create a service endpoint
+func (k *keystone) CreateEndpoint(opts EndpointOpts) (endpoint Endpoint, err error) {
+	path := k.identityURL() + endpointsPath
+
+	var jsonResponse endpointResponse
+	err = k.do("POST", path, "CreateEndpoint", endpointRequest{Endpoint: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	endpoint = jsonResponse.Endpoint
+	return
+}
+
+// UpdateEndpoint update only the fields set in opts
+func (k *keystone) UpdateEndpoint(endpointID string, opts EndpointOpts) (endpoint Endpoint, err error) {
+	path := k.identityURL() + strings.Replace(endpointPath, "$id", endpointID, -1)
+
+	var jsonResponse endpointResponse
+	err = k.do("PATCH", path, "UpdateEndpoint", endpointRequest{Endpoint: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	endpoint = jsonResponse.Endpoint
+	return
+}
+
+// DeleteEndpoint delete endpoint by id
+func (k *keystone) DeleteEndpoint(endpointID string) (err error) {
+	path := k.identityURL() + strings.Replace(endpointPath, "$id", endpointID, -1)
+	return k.do("DELETE", path, "DeleteEndpoint", nil, nil)
+}
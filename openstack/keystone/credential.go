@@ -0,0 +1,217 @@
+package keystone
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const ec2CredentialsPath = "/users/$id/credentials/OS-EC2"
+const ec2CredentialPath = "/users/$id/credentials/OS-EC2/$access"
+const ec2TokensPath = "/ec2tokens"
+const credentialsPath = "/credentials"
+const credentialPath = "/credentials/$id"
+
+// ec2Keys access and secret used to sign /ec2tokens requests
+type ec2Keys struct {
+	access string
+	secret string
+}
+
+// NewEC2 instance of keystone authenticating through /ec2tokens with a request signed by the EC2 secret
+// the token is scoped to the project of the EC2 credential
+func NewEC2(access, secret, endpoint string) Keystone {
+	return newClient(&keystone{Token: token{}, Endpoint: endpoint, Endpoints: make(map[string]string), ec2: &ec2Keys{access: access, secret: secret}, mux: new(sync.Mutex)})
+}
+
+// authenticateEC2 sign a request with the EC2 secret and exchange it for a token, the secret itself is never sent
+func (k *keystone) authenticateEC2() (err error) {
+	endpoint, err := url.Parse(k.identityURL() + ec2TokensPath)
+	if err != nil {
+		return
+	}
+
+	var auth ec2Auth
+	auth.Credentials.Access = k.ec2.access
+	auth.Credentials.Host = endpoint.Host
+	auth.Credentials.Verb = "POST"
+	auth.Credentials.Path = endpoint.Path
+	auth.Credentials.Headers = map[string]string{}
+	auth.Credentials.Params = map[string]string{
+		"AWSAccessKeyId":   k.ec2.access,
+		"SignatureMethod":  "HmacSHA256",
+		"SignatureVersion": "2",
+		"Timestamp":        time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+	}
+	auth.Credentials.Signature = signEC2(k.ec2.secret, auth.Credentials.Verb, auth.Credentials.Host, auth.Credentials.Path, auth.Credentials.Params)
+
+	payload, err := json.Marshal(auth)
+	if err != nil {
+		return
+	}
+
+	resp, err := k.client.DoRequest(context.TODO(), "POST", endpoint.String(), bytes.NewBuffer(payload))
+	if err != nil {
+		return
+	}
+
+	return k.setToken(resp)
+}
+
+// signEC2 EC2 signature version 2, the same string keystone builds to verify the request
+func signEC2(secret, verb, host, path string, params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		if key != "Signature" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, ec2Escape(key)+"="+ec2Escape(params[key]))
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(verb + "\n" + host + "\n" + path + "\n" + strings.Join(pairs, "&")))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ec2Escape percent encode everything but unreserved characters, spaces become %20 not +
+func ec2Escape(value string) string {
+	return strings.Replace(url.QueryEscape(value), "+", "%20", -1)
+}
+
+// ListEC2Credentials list the user EC2 credentials
+func (k *keystone) ListEC2Credentials(userID string) (credentials []EC2Credential, err error) {
+	path := k.identityURL() + strings.Replace(ec2CredentialsPath, "$id", userID, -1)
+
+	var jsonResponse ec2CredentialsResponse
+	err = k.do("GET", path, "ListEC2Credentials", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	credentials = jsonResponse.Credentials
+	return
+}
+
+// GetEC2Credential get EC2 credential by access key
+func (k *keystone) GetEC2Credential(userID, access string) (credential EC2Credential, err error) {
+	var jsonResponse ec2CredentialResponse
+	err = k.do("GET", k.ec2CredentialPath(userID, access), "GetEC2Credential", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	credential = jsonResponse.Credential
+	return
+}
+
+// CreateEC2Credential create an EC2 credential for the user on projectID
+func (k *keystone) CreateEC2Credential(userID, projectID string) (credential EC2Credential, err error) {
+	path := k.identityURL() + strings.Replace(ec2CredentialsPath, "$id", userID, -1)
+
+	var jsonResponse ec2CredentialResponse
+	err = k.do("POST", path, "CreateEC2Credential", ec2CredentialRequest{ProjectID: projectID}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	credential = jsonResponse.Credential
+	return
+}
+
+// DeleteEC2Credential delete EC2 credential by access key
+func (k *keystone) DeleteEC2Credential(userID, access string) (err error) {
+	return k.do("DELETE", k.ec2CredentialPath(userID, access), "DeleteEC2Credential", nil, nil)
+}
+
+func (k *keystone) ec2CredentialPath(userID, access string) string {
+	path := strings.Replace(ec2CredentialPath, "$id", userID, -1)
+	return k.identityURL() + strings.Replace(path, "$access", access, -1)
+}
+
+// ListCredentials list credentials matching opts
+func (k *keystone) ListCredentials(opts ListCredentialsOpts) (credentials []Credential, err error) {
+	path := k.identityURL() + credentialsPath
+	query := url.Values{}
+	if opts.UserID != "" {
+		query.Set("user_id", opts.UserID)
+	}
+	if opts.Type != "" {
+		query.Set("type", opts.Type)
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	for path != "" {
+		var jsonResponse CredentialResponse
+		err = k.do("GET", path, "ListCredentials", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		credentials = append(credentials, jsonResponse.Credentials...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetCredential get credential by id
+func (k *keystone) GetCredential(credentialID string) (credential Credential, err error) {
+	path := k.identityURL() + strings.Replace(credentialPath, "$id", credentialID, -1)
+
+	var jsonResponse credentialResponse
+	err = k.do("GET", path, "GetCredential", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	credential = jsonResponse.Credential
+	return
+}
+
+// CreateCredential create a credential
+func (k *keystone) CreateCredential(opts CredentialOpts) (credential Credential, err error) {
+	path := k.identityURL() + credentialsPath
+
+	var jsonResponse credentialResponse
+	err = k.do("POST", path, "CreateCredential", credentialRequest{Credential: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	credential = jsonResponse.Credential
+	return
+}
+
+// UpdateCredential update only the fields set in opts
+func (k *keystone) UpdateCredential(credentialID string, opts CredentialOpts) (credential Credential, err error) {
+	path := k.identityURL() + strings.Replace(credentialPath, "$id", credentialID, -1)
+
+	var jsonResponse credentialResponse
+	err = k.do("PATCH", path, "UpdateCredential", credentialRequest{Credential: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	credential = jsonResponse.Credential
+	return
+}
+
+// DeleteCredential delete credential by id
+func (k *keystone) DeleteCredential(credentialID string) (err error) {
+	path := k.identityURL() + strings.Replace(credentialPath, "$id", credentialID, -1)
+	return k.do("DELETE", path, "DeleteCredential", nil, nil)
+}
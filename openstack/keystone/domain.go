@@ -0,0 +1,85 @@
+package keystone
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const domainsPath = "/domains"
+const domainPath = "/domains/$id"
+
+// ListDomains list domains matching opts
+func (k *keystone) ListDomains(opts ListDomainsOpts) (domains []Domain, err error) {
+	path := k.identityURL() + domainsPath
+	query := url.Values{}
+	if opts.Name != "" {
+		query.Set("name", opts.Name)
+	}
+	if opts.Enabled != nil {
+		query.Set("enabled", strconv.FormatBool(*opts.Enabled))
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	for path != "" {
+		var jsonResponse DomainResponse
+		err = k.do("GET", path, "ListDomains", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		domains = append(domains, jsonResponse.Domains...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetDomain get domain by id
+func (k *keystone) GetDomain(domainID string) (domain Domain, err error) {
+	path := k.identityURL() + strings.Replace(domainPath, "$id", domainID, -1)
+
+	var jsonResponse domainResponse
+	err = k.do("GET", path, "GetDomain", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	domain = jsonResponse.Domain
+	return
+}
+
+// CreateDomain create a domain
+func (k *keystone) CreateDomain(opts DomainOpts) (domain Domain, err error) {
+	path := k.identityURL() + domainsPath
+
+	var jsonResponse domainResponse
+	err = k.do("POST", path, "CreateDomain", domainRequest{Domain: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	domain = jsonResponse.Domain
+	return
+}
+
+// UpdateDomain update only the fields set in opts
+func (k *keystone) UpdateDomain(domainID string, opts DomainOpts) (domain Domain, err error) {
+	path := k.identityURL() + strings.Replace(domainPath, "$id", domainID, -1)
+
+	var jsonResponse domainResponse
+	err = k.do("PATCH", path, "UpdateDomain", domainRequest{Domain: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	domain = jsonResponse.Domain
+	return
+}
+
+// DeleteDomain delete domain by id, the domain must be disabled with UpdateDomain first
+func (k *keystone) DeleteDomain(domainID string) (err error) {
+	path := k.identityURL() + strings.Replace(domainPath, "$id", domainID, -1)
+	return k.do("DELETE", path, "DeleteDomain", nil, nil)
+}
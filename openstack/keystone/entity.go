@@ -1,6 +1,7 @@
 package keystone
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -16,12 +17,16 @@ type token struct {
 
 type authScoped struct {
 	Identity identity `json:"identity"`
-	Scope    scope    `json:"scope,omitempty"`
+	Scope    *scope   `json:"scope,omitempty"`
 }
 
 type identity struct {
-	Methods  []string `json:"methods"`
-	Password password `json:"password"`
+	Methods  []string     `json:"methods"`
+	Password *password    `json:"password,omitempty"`
+	Token    *tokenMethod `json:"token,omitempty"`
+	TOTP     *totpMethod  `json:"totp,omitempty"`
+
+	ApplicationCredential *applicationCredentialMethod `json:"application_credential,omitempty"`
 }
 
 type password struct {
@@ -34,62 +39,996 @@ type user struct {
 	Password string `json:"password"`
 }
 
+// tokenMethod authenticate with an existing token, used for rescoping
+type tokenMethod struct {
+	ID string `json:"id"`
+}
+
+// totpMethod time based one time password, the user is taken from the password method
+type totpMethod struct {
+	User struct {
+		Name     string `json:"name"`
+		Domain   domain `json:"domain"`
+		Passcode string `json:"passcode"`
+	} `json:"user"`
+}
+
+// applicationCredentialMethod the scope is part of the application credential so none is sent
+type applicationCredentialMethod struct {
+	ID     string `json:"id"`
+	Secret string `json:"secret"`
+}
+
+// PasscodeSupplier returns the current TOTP passcode, it is called on every authentication that needs one
+type PasscodeSupplier func() (passcode string, err error)
+
 type scope struct {
-	Project project `json:"project"`
+	Project *project `json:"project,omitempty"`
+	Trust   *trust   `json:"OS-TRUST:trust,omitempty"`
 	// Domain domain `json:"domain"`
 }
 
+type trust struct {
+	ID string `json:"id"`
+}
+
 type domain struct {
 	ID string `json:"id,omitempty"`
 }
 
+// project scope by ID or by Name and Domain
 type project struct {
-	Domain domain `json:"domain"`
-	Name   string `json:"name"`
+	ID     string  `json:"id,omitempty"`
+	Domain *domain `json:"domain,omitempty"`
+	Name   string  `json:"name,omitempty"`
 }
 
 type resp struct {
-	Token struct {
-		ExpiresAt time.Time `json:"expires_at"`
-		Project   struct {
-			Domain struct {
-				ID   string `json:"id"`
-				Name string `json:"name"`
-			} `json:"domain"`
-			ID   string `json:"id"`
-			Name string `json:"name"`
-		} `json:"project"`
-		Catalog []struct {
-			Endpoints []struct {
-				URL       string `json:"url"`
-				Interface string `json:"interface"`
-				Region    string `json:"region"`
-				RegionID  string `json:"region_id"`
-				ID        string `json:"id"`
-			} `json:"endpoints"`
-			Type string `json:"type"`
-			ID   string `json:"id"`
-			Name string `json:"name"`
-		} `json:"catalog"`
-	} `json:"token"`
+	Token TokenInfo `json:"token"`
+}
+
+// TokenInfo token body returned on authentication and validation, Project is empty for unscoped and domain scoped tokens
+type TokenInfo struct {
+	ExpiresAt time.Time   `json:"expires_at"`
+	IssuedAt  time.Time   `json:"issued_at"`
+	Methods   []string    `json:"methods"`
+	AuditIDs  []string    `json:"audit_ids"`
+	User      Reference   `json:"user"`
+	Project   Reference   `json:"project"`
+	Domain    Reference   `json:"domain"`
+	Roles     []Reference `json:"roles"`
+	System    *struct {
+		All bool `json:"all"`
+	} `json:"system,omitempty"`
+	IsDomain bool      `json:"is_domain"`
+	Catalog  []Service `json:"catalog"`
+	// Trust is set for trust scoped tokens
+	Trust *struct {
+		ID            string    `json:"id"`
+		Impersonation bool      `json:"impersonation"`
+		TrustorUser   Reference `json:"trustor_user"`
+		TrusteeUser   Reference `json:"trustee_user"`
+	} `json:"OS-TRUST:trust,omitempty"`
+}
+
+// ValidateTokenOpts NoCatalog skips the catalog in the response, AllowExpired lets services validate recently expired tokens
+type ValidateTokenOpts struct {
+	NoCatalog    bool
+	AllowExpired bool
+}
+
+// Service catalog entry, Endpoints is only set in the token catalog
+type Service struct {
+	Endpoints   []Endpoint `json:"endpoints,omitempty"`
+	Type        string     `json:"type"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Enabled     bool       `json:"enabled,omitempty"`
+}
+
+// Endpoint service endpoint, ServiceID and Enabled are only set by the endpoints API
+type Endpoint struct {
+	URL       string `json:"url"`
+	Interface string `json:"interface"`
+	Region    string `json:"region"`
+	RegionID  string `json:"region_id"`
+	ID        string `json:"id"`
+	ServiceID string `json:"service_id,omitempty"`
+	Enabled   bool   `json:"enabled,omitempty"`
+}
+
+// links keystone collection links, Next is only set when the collection is paginated
+type links struct {
+	Self     string `json:"self"`
+	Next     string `json:"next"`
+	Previous string `json:"previous"`
 }
 
 // ProjectResponse list all project type
 type ProjectResponse struct {
-	Projects []projectData `json:"projects"`
+	Projects []Project `json:"projects"`
+	Links    links     `json:"links"`
 }
 
-// projectData project metadata
-type projectData struct {
+type projectResponse struct {
+	Project Project `json:"project"`
+}
+
+// Project project metadata
+type Project struct {
 	IsDomain    bool   `json:"is_domain"`
 	Description string `json:"description"`
 	Links       struct {
 		Self string `json:"self"`
 	} `json:"links"`
-	Tags     []interface{} `json:"tags"`
-	Enabled  bool          `json:"enabled"`
-	ID       string        `json:"id"`
-	ParentID string        `json:"parent_id"`
-	DomainID string        `json:"domain_id"`
-	Name     string        `json:"name"`
+	Tags     []string `json:"tags"`
+	Enabled  bool     `json:"enabled"`
+	ID       string   `json:"id"`
+	ParentID string   `json:"parent_id"`
+	DomainID string   `json:"domain_id"`
+	Name     string   `json:"name"`
+}
+
+// ProjectOpts create and update project fields, DomainID, ParentID and IsDomain are ignored on update
+type ProjectOpts struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	DomainID    string   `json:"domain_id,omitempty"`
+	ParentID    string   `json:"parent_id,omitempty"`
+	IsDomain    bool     `json:"is_domain,omitempty"`
+	Enabled     *bool    `json:"enabled,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+type projectRequest struct {
+	Project ProjectOpts `json:"project"`
+}
+
+// ListProjectsOpts project filters, Tags and NotTags must all match, TagsAny and NotTagsAny at least one
+type ListProjectsOpts struct {
+	DomainID   string
+	ParentID   string
+	Name       string
+	Enabled    *bool
+	IsDomain   *bool
+	Tags       []string
+	TagsAny    []string
+	NotTags    []string
+	NotTagsAny []string
+	Limit      int
+	Marker     string
+}
+
+type tagsBody struct {
+	Tags []string `json:"tags"`
+}
+
+// UserResponse list users type
+type UserResponse struct {
+	Users []User `json:"users"`
+	Links links  `json:"links"`
+}
+
+type userResponse struct {
+	User User `json:"user"`
+}
+
+// User keystone user
+type User struct {
+	ID                string      `json:"id"`
+	Name              string      `json:"name"`
+	DomainID          string      `json:"domain_id"`
+	DefaultProjectID  string      `json:"default_project_id"`
+	Description       string      `json:"description"`
+	Enabled           bool        `json:"enabled"`
+	PasswordExpiresAt string      `json:"password_expires_at"`
+	Options           UserOptions `json:"options"`
+	Links             struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+// UserOptions per user security compliance options, nil fields are left untouched
+type UserOptions struct {
+	IgnoreChangePasswordUponFirstUse *bool `json:"ignore_change_password_upon_first_use,omitempty"`
+	IgnorePasswordExpiry             *bool `json:"ignore_password_expiry,omitempty"`
+	IgnoreLockoutFailureAttempts     *bool `json:"ignore_lockout_failure_attempts,omitempty"`
+	IgnoreUserInactivity             *bool `json:"ignore_user_inactivity,omitempty"`
+	LockPassword                     *bool `json:"lock_password,omitempty"`
+	MultiFactorAuthEnabled           *bool `json:"multi_factor_auth_enabled,omitempty"`
+	// MultiFactorAuthRules every rule is a list of auth methods e.g. [["password", "totp"]]
+	MultiFactorAuthRules [][]string `json:"multi_factor_auth_rules,omitempty"`
+}
+
+// UserOpts create and update user fields
+type UserOpts struct {
+	Name             string       `json:"name,omitempty"`
+	DomainID         string       `json:"domain_id,omitempty"`
+	DefaultProjectID string       `json:"default_project_id,omitempty"`
+	Description      string       `json:"description,omitempty"`
+	Password         string       `json:"password,omitempty"`
+	Enabled          *bool        `json:"enabled,omitempty"`
+	Options          *UserOptions `json:"options,omitempty"`
+}
+
+type userRequest struct {
+	User UserOpts `json:"user"`
+}
+
+type passwordChange struct {
+	User struct {
+		Password         string `json:"password"`
+		OriginalPassword string `json:"original_password"`
+	} `json:"user"`
+}
+
+// ListUsersOpts user filters
+type ListUsersOpts struct {
+	DomainID   string
+	Name       string
+	Enabled    *bool
+	IdpID      string
+	ProtocolID string
+	UniqueID   string
+	Limit      int
+	Marker     string
+}
+
+// GroupResponse list groups type
+type GroupResponse struct {
+	Groups []Group `json:"groups"`
+	Links  links   `json:"links"`
+}
+
+type groupResponse struct {
+	Group Group `json:"group"`
+}
+
+// Group keystone group
+type Group struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DomainID    string `json:"domain_id"`
+	Description string `json:"description"`
+	Links       struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+// GroupOpts create and update group fields
+type GroupOpts struct {
+	Name        string `json:"name,omitempty"`
+	DomainID    string `json:"domain_id,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type groupRequest struct {
+	Group GroupOpts `json:"group"`
+}
+
+// ListGroupsOpts group filters
+type ListGroupsOpts struct {
+	DomainID string
+	Name     string
+	Limit    int
+	Marker   string
+}
+
+// RoleResponse list roles type
+type RoleResponse struct {
+	Roles []Role `json:"roles"`
+	Links links  `json:"links"`
+}
+
+type roleResponse struct {
+	Role Role `json:"role"`
+}
+
+// Role keystone role, DomainID is set for domain specific roles
+type Role struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	DomainID    string                 `json:"domain_id"`
+	Description string                 `json:"description"`
+	Options     map[string]interface{} `json:"options"`
+	Links       struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+// RoleOpts create and update role fields, DomainID creates a domain specific role
+type RoleOpts struct {
+	Name        string                 `json:"name,omitempty"`
+	DomainID    string                 `json:"domain_id,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Options     map[string]interface{} `json:"options,omitempty"`
+}
+
+type roleRequest struct {
+	Role RoleOpts `json:"role"`
+}
+
+// ListRolesOpts role filters, set DomainID to list domain specific roles
+type ListRolesOpts struct {
+	Name     string
+	DomainID string
+}
+
+// RoleGrant who gets the role where, exactly one of UserID/GroupID and one of ProjectID/DomainID/System must be set
+type RoleGrant struct {
+	RoleID    string
+	UserID    string
+	GroupID   string
+	ProjectID string
+	DomainID  string
+	System    bool
+}
+
+// Reference id and name of an entity, Name is only set when asked for with include_names
+type Reference struct {
+	ID     string     `json:"id,omitempty"`
+	Name   string     `json:"name,omitempty"`
+	Domain *Reference `json:"domain,omitempty"`
+}
+
+// RoleAssignmentResponse list role assignments type
+type RoleAssignmentResponse struct {
+	RoleAssignments []RoleAssignment `json:"role_assignments"`
+	Links           links            `json:"links"`
+}
+
+// RoleAssignment a single role assignment, only one of User and Group is set
+type RoleAssignment struct {
+	Role  Reference  `json:"role"`
+	User  *Reference `json:"user"`
+	Group *Reference `json:"group"`
+	Scope struct {
+		Project *Reference `json:"project"`
+		Domain  *Reference `json:"domain"`
+		System  *struct {
+			All bool `json:"all"`
+		} `json:"system"`
+		InheritedTo string `json:"OS-INHERIT:inherited_to"`
+	} `json:"scope"`
+	Links struct {
+		Assignment string `json:"assignment"`
+		Membership string `json:"membership"`
+	} `json:"links"`
+}
+
+// ListRoleAssignmentsOpts role assignment filters, Effective expands group memberships and inherited roles
+type ListRoleAssignmentsOpts struct {
+	UserID         string
+	GroupID        string
+	RoleID         string
+	ProjectID      string
+	DomainID       string
+	System         bool
+	Effective      bool
+	IncludeNames   bool
+	IncludeSubtree bool
+}
+
+// RoleInference a prior role and the roles it implies
+type RoleInference struct {
+	PriorRole Reference   `json:"prior_role"`
+	Implies   []Reference `json:"implies"`
+}
+
+type roleInferenceResponse struct {
+	RoleInference RoleInference `json:"role_inference"`
+}
+
+type roleInferencesResponse struct {
+	RoleInferences []RoleInference `json:"role_inferences"`
+}
+
+// DomainResponse list domains type
+type DomainResponse struct {
+	Domains []Domain `json:"domains"`
+	Links   links    `json:"links"`
+}
+
+type domainResponse struct {
+	Domain Domain `json:"domain"`
+}
+
+// Domain keystone domain
+type Domain struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Enabled     bool                   `json:"enabled"`
+	Tags        []string               `json:"tags"`
+	Options     map[string]interface{} `json:"options"`
+}
+
+// DomainOpts create and update domain fields, a domain has to be disabled before it can be deleted
+type DomainOpts struct {
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Enabled     *bool                  `json:"enabled,omitempty"`
+	Tags        []string               `json:"tags,omitempty"`
+	Options     map[string]interface{} `json:"options,omitempty"`
+}
+
+type domainRequest struct {
+	Domain DomainOpts `json:"domain"`
+}
+
+// ListDomainsOpts domain filters
+type ListDomainsOpts struct {
+	Name    string
+	Enabled *bool
+}
+
+// RegionResponse list regions type
+type RegionResponse struct {
+	Regions []Region `json:"regions"`
+	Links   links    `json:"links"`
+}
+
+type regionResponse struct {
+	Region Region `json:"region"`
+}
+
+// Region keystone region, regions can be nested with ParentRegionID
+type Region struct {
+	ID             string `json:"id"`
+	Description    string `json:"description"`
+	ParentRegionID string `json:"parent_region_id"`
+}
+
+// RegionOpts create and update region fields, keystone generates the ID when it is empty, the ID can't be updated
+type RegionOpts struct {
+	ID             string `json:"id,omitempty"`
+	Description    string `json:"description,omitempty"`
+	ParentRegionID string `json:"parent_region_id,omitempty"`
+}
+
+type regionRequest struct {
+	Region RegionOpts `json:"region"`
+}
+
+// ServiceResponse list services type
+type ServiceResponse struct {
+	Services []Service `json:"services"`
+	Links    links     `json:"links"`
+}
+
+type serviceResponse struct {
+	Service Service `json:"service"`
+}
+
+// ServiceOpts create and update service fields
+type ServiceOpts struct {
+	Type        string `json:"type,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+type serviceRequest struct {
+	Service ServiceOpts `json:"service"`
+}
+
+// EndpointResponse list endpoints type
+type EndpointResponse struct {
+	Endpoints []Endpoint `json:"endpoints"`
+	Links     links      `json:"links"`
+}
+
+type endpointResponse struct {
+	Endpoint Endpoint `json:"endpoint"`
+}
+
+// EndpointOpts create and update endpoint fields
+type EndpointOpts struct {
+	Interface string `json:"interface,omitempty"`
+	URL       string `json:"url,omitempty"`
+	RegionID  string `json:"region_id,omitempty"`
+	ServiceID string `json:"service_id,omitempty"`
+	Enabled   *bool  `json:"enabled,omitempty"`
+}
+
+type endpointRequest struct {
+	Endpoint EndpointOpts `json:"endpoint"`
+}
+
+// ListEndpointsOpts endpoint filters
+type ListEndpointsOpts struct {
+	Interface string
+	RegionID  string
+	ServiceID string
+}
+
+// OIDCOptions federated OpenID Connect authentication, set either TokenEndpoint or DiscoveryEndpoint for the password and client credentials flows
+type OIDCOptions struct {
+	// AuthType one of OIDCPassword, OIDCClientCredentials or OIDCAccessToken
+	AuthType          string
+	IdentityProvider  string
+	Protocol          string
+	TokenEndpoint     string
+	DiscoveryEndpoint string
+	ClientID          string
+	ClientSecret      string
+	Username          string
+	Password          string
+	// AccessToken used as is by OIDCAccessToken
+	AccessToken string
+	// AccessTokenType token response field sent to keystone, access_token by default some setups require id_token
+	AccessTokenType string
+	// Scope defaults to openid
+	Scope []string
+}
+
+type oidcDiscovery struct {
+	TokenEndpoint string `json:"token_endpoint"`
+}
+
+// TrustResponse list trusts type
+type TrustResponse struct {
+	Trusts []Trust `json:"trusts"`
+	Links  links   `json:"links"`
+}
+
+type trustResponse struct {
+	Trust Trust `json:"trust"`
+}
+
+// Trust delegation of roles on a project from the trustor to the trustee, nil RemainingUses means unlimited
+type Trust struct {
+	ID                 string      `json:"id"`
+	TrustorUserID      string      `json:"trustor_user_id"`
+	TrusteeUserID      string      `json:"trustee_user_id"`
+	ProjectID          string      `json:"project_id"`
+	Impersonation      bool        `json:"impersonation"`
+	ExpiresAt          *time.Time  `json:"expires_at"`
+	RemainingUses      *int        `json:"remaining_uses"`
+	Roles              []Reference `json:"roles"`
+	AllowRedelegation  bool        `json:"allow_redelegation"`
+	RedelegationCount  int         `json:"redelegation_count"`
+	RedelegatedTrustID string      `json:"redelegated_trust_id"`
+}
+
+// TrustOpts create trust fields, roles can be referenced by ID or Name
+type TrustOpts struct {
+	TrustorUserID     string      `json:"trustor_user_id"`
+	TrusteeUserID     string      `json:"trustee_user_id"`
+	ProjectID         string      `json:"project_id,omitempty"`
+	Impersonation     bool        `json:"impersonation"`
+	ExpiresAt         *time.Time  `json:"expires_at,omitempty"`
+	RemainingUses     *int        `json:"remaining_uses,omitempty"`
+	Roles             []Reference `json:"roles,omitempty"`
+	AllowRedelegation bool        `json:"allow_redelegation,omitempty"`
+	RedelegationCount *int        `json:"redelegation_count,omitempty"`
+}
+
+type trustRequest struct {
+	Trust TrustOpts `json:"trust"`
+}
+
+// ListTrustsOpts trust filters
+type ListTrustsOpts struct {
+	TrustorUserID string
+	TrusteeUserID string
+}
+
+// ApplicationCredentialResponse list application credentials type
+type ApplicationCredentialResponse struct {
+	ApplicationCredentials []ApplicationCredential `json:"application_credentials"`
+	Links                  links                   `json:"links"`
+}
+
+type applicationCredentialResponse struct {
+	ApplicationCredential ApplicationCredential `json:"application_credential"`
+}
+
+// ApplicationCredential Secret is only returned once on creation
+type ApplicationCredential struct {
+	ID           string       `json:"id"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	Secret       string       `json:"secret"`
+	ProjectID    string       `json:"project_id"`
+	ExpiresAt    *time.Time   `json:"expires_at"`
+	Unrestricted bool         `json:"unrestricted"`
+	Roles        []Reference  `json:"roles"`
+	AccessRules  []AccessRule `json:"access_rules"`
+}
+
+// AccessRule limit an application credential to a service API path, Path may contain * and ** wildcards
+type AccessRule struct {
+	ID      string `json:"id,omitempty"`
+	Path    string `json:"path"`
+	Method  string `json:"method"`
+	Service string `json:"service"`
+}
+
+// ApplicationCredentialOpts create application credential fields, keystone generates the secret when empty
+// Unrestricted allows the credential to create other application credentials and trusts
+type ApplicationCredentialOpts struct {
+	Name         string       `json:"name"`
+	Description  string       `json:"description,omitempty"`
+	Secret       string       `json:"secret,omitempty"`
+	ExpiresAt    *time.Time   `json:"expires_at,omitempty"`
+	Roles        []Reference  `json:"roles,omitempty"`
+	Unrestricted bool         `json:"unrestricted,omitempty"`
+	AccessRules  []AccessRule `json:"access_rules,omitempty"`
+}
+
+type applicationCredentialRequest struct {
+	ApplicationCredential ApplicationCredentialOpts `json:"application_credential"`
+}
+
+// EC2Credential S3/EC2 style access and secret key of a user on a project
+type EC2Credential struct {
+	UserID    string `json:"user_id"`
+	ProjectID string `json:"tenant_id"`
+	Access    string `json:"access"`
+	Secret    string `json:"secret"`
+	TrustID   string `json:"trust_id"`
+}
+
+type ec2CredentialResponse struct {
+	Credential EC2Credential `json:"credential"`
+}
+
+type ec2CredentialsResponse struct {
+	Credentials []EC2Credential `json:"credentials"`
+}
+
+type ec2CredentialRequest struct {
+	ProjectID string `json:"tenant_id"`
+}
+
+// ec2Auth /ec2tokens request, keystone recomputes the signature with the stored secret
+type ec2Auth struct {
+	Credentials struct {
+		Access    string            `json:"access"`
+		Host      string            `json:"host"`
+		Verb      string            `json:"verb"`
+		Path      string            `json:"path"`
+		Params    map[string]string `json:"params"`
+		Headers   map[string]string `json:"headers"`
+		BodyHash  string            `json:"body_hash"`
+		Signature string            `json:"signature"`
+	} `json:"credentials"`
+}
+
+// CredentialResponse list credentials type
+type CredentialResponse struct {
+	Credentials []Credential `json:"credentials"`
+	Links       links        `json:"links"`
+}
+
+type credentialResponse struct {
+	Credential Credential `json:"credential"`
+}
+
+// Credential generic keystone credential, Blob is a serialized JSON document whose format depends on Type e.g. ec2, totp or cert
+type Credential struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	ProjectID string `json:"project_id"`
+	Type      string `json:"type"`
+	Blob      string `json:"blob"`
+}
+
+// CredentialOpts create and update credential fields
+type CredentialOpts struct {
+	UserID    string `json:"user_id,omitempty"`
+	ProjectID string `json:"project_id,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Blob      string `json:"blob,omitempty"`
+}
+
+type credentialRequest struct {
+	Credential CredentialOpts `json:"credential"`
+}
+
+// ListCredentialsOpts credential filters
+type ListCredentialsOpts struct {
+	UserID string
+	Type   string
+}
+
+// RegisteredLimitResponse list registered limits type
+type RegisteredLimitResponse struct {
+	RegisteredLimits []RegisteredLimit `json:"registered_limits"`
+	Links            links             `json:"links"`
+}
+
+type registeredLimitResponse struct {
+	RegisteredLimit RegisteredLimit `json:"registered_limit"`
+}
+
+// RegisteredLimit default limit of a resource for every project
+type RegisteredLimit struct {
+	ID           string `json:"id"`
+	ServiceID    string `json:"service_id"`
+	RegionID     string `json:"region_id"`
+	ResourceName string `json:"resource_name"`
+	DefaultLimit int    `json:"default_limit"`
+	Description  string `json:"description"`
+}
+
+// RegisteredLimitOpts create and update registered limit fields
+type RegisteredLimitOpts struct {
+	ServiceID    string `json:"service_id,omitempty"`
+	RegionID     string `json:"region_id,omitempty"`
+	ResourceName string `json:"resource_name,omitempty"`
+	DefaultLimit *int   `json:"default_limit,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+type registeredLimitsRequest struct {
+	RegisteredLimits []RegisteredLimitOpts `json:"registered_limits"`
+}
+
+type registeredLimitRequest struct {
+	RegisteredLimit RegisteredLimitOpts `json:"registered_limit"`
+}
+
+// LimitResponse list limits type
+type LimitResponse struct {
+	Limits []Limit `json:"limits"`
+	Links  links   `json:"links"`
+}
+
+type limitResponse struct {
+	Limit Limit `json:"limit"`
+}
+
+// Limit project or domain override of a registered limit
+type Limit struct {
+	ID            string `json:"id"`
+	ProjectID     string `json:"project_id"`
+	DomainID      string `json:"domain_id"`
+	ServiceID     string `json:"service_id"`
+	RegionID      string `json:"region_id"`
+	ResourceName  string `json:"resource_name"`
+	ResourceLimit int    `json:"resource_limit"`
+	Description   string `json:"description"`
+}
+
+// LimitOpts create and update limit fields, only ResourceLimit and Description can be updated
+type LimitOpts struct {
+	ProjectID     string `json:"project_id,omitempty"`
+	DomainID      string `json:"domain_id,omitempty"`
+	ServiceID     string `json:"service_id,omitempty"`
+	RegionID      string `json:"region_id,omitempty"`
+	ResourceName  string `json:"resource_name,omitempty"`
+	ResourceLimit *int   `json:"resource_limit,omitempty"`
+	Description   string `json:"description,omitempty"`
+}
+
+type limitsRequest struct {
+	Limits []LimitOpts `json:"limits"`
+}
+
+type limitRequest struct {
+	Limit LimitOpts `json:"limit"`
+}
+
+// ListLimitsOpts limit and registered limit filters, ProjectID and DomainID only apply to limits
+type ListLimitsOpts struct {
+	ServiceID    string
+	RegionID     string
+	ResourceName string
+	ProjectID    string
+	DomainID     string
+}
+
+// LimitModel enforcement model, flat or strict-two-level
+type LimitModel struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type limitModelResponse struct {
+	Model LimitModel `json:"model"`
+}
+
+// LimitUsage returns the current usage of the resource in projectID, used by CheckLimit
+type LimitUsage func(projectID string) (usage int, err error)
+
+// CheckLimitOpts resource to check and how much of it is about to be consumed
+type CheckLimitOpts struct {
+	ProjectID    string
+	ServiceID    string
+	RegionID     string
+	ResourceName string
+	Delta        int
+	Usage        LimitUsage
+}
+
+// LimitExceededError usage plus delta is above the effective limit of ProjectID
+type LimitExceededError struct {
+	ProjectID    string
+	ResourceName string
+	Limit        int
+	Usage        int
+	Delta        int
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("limit exceeded for %v in project %v: usage %v plus %v is above %v", e.ResourceName, e.ProjectID, e.Usage, e.Delta, e.Limit)
+}
+
+// IdentityProviderResponse list identity providers type
+type IdentityProviderResponse struct {
+	IdentityProviders []IdentityProvider `json:"identity_providers"`
+	Links             links              `json:"links"`
+}
+
+type identityProviderResponse struct {
+	IdentityProvider IdentityProvider `json:"identity_provider"`
+}
+
+// IdentityProvider federated identity provider, RemoteIDs are the issuer ids sent by the idp
+type IdentityProvider struct {
+	ID               string   `json:"id"`
+	DomainID         string   `json:"domain_id"`
+	Description      string   `json:"description"`
+	Enabled          bool     `json:"enabled"`
+	RemoteIDs        []string `json:"remote_ids"`
+	AuthorizationTTL *int     `json:"authorization_ttl"`
+}
+
+// IdentityProviderOpts create and update identity provider fields
+type IdentityProviderOpts struct {
+	DomainID         string   `json:"domain_id,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	Enabled          *bool    `json:"enabled,omitempty"`
+	RemoteIDs        []string `json:"remote_ids,omitempty"`
+	AuthorizationTTL *int     `json:"authorization_ttl,omitempty"`
+}
+
+type identityProviderRequest struct {
+	IdentityProvider IdentityProviderOpts `json:"identity_provider"`
+}
+
+// MappingResponse list mappings type
+type MappingResponse struct {
+	Mappings []Mapping `json:"mappings"`
+	Links    links     `json:"links"`
+}
+
+type mappingResponse struct {
+	Mapping Mapping `json:"mapping"`
+}
+
+// Mapping translate federated assertions into local users, groups and projects
+type Mapping struct {
+	ID            string        `json:"id"`
+	Rules         []MappingRule `json:"rules"`
+	SchemaVersion string        `json:"schema_version,omitempty"`
+}
+
+// MappingOpts create and update mapping fields
+type MappingOpts struct {
+	Rules         []MappingRule `json:"rules"`
+	SchemaVersion string        `json:"schema_version,omitempty"`
+}
+
+type mappingRequest struct {
+	Mapping MappingOpts `json:"mapping"`
+}
+
+// MappingRule when every Remote requirement matches the Local entries are applied, {0} refers to the first remote value
+type MappingRule struct {
+	Local  []MappingLocal  `json:"local"`
+	Remote []MappingRemote `json:"remote"`
+}
+
+// MappingLocal local identity values, Groups and GroupIDs accept a list of names or ids e.g. "{1}"
+type MappingLocal struct {
+	User     *MappingUser     `json:"user,omitempty"`
+	Group    *MappingGroup    `json:"group,omitempty"`
+	Groups   string           `json:"groups,omitempty"`
+	GroupIDs string           `json:"group_ids,omitempty"`
+	Domain   *Reference       `json:"domain,omitempty"`
+	Projects []MappingProject `json:"projects,omitempty"`
+}
+
+// MappingUser Type is ephemeral by default or local for existing users
+type MappingUser struct {
+	ID     string     `json:"id,omitempty"`
+	Name   string     `json:"name,omitempty"`
+	Email  string     `json:"email,omitempty"`
+	Type   string     `json:"type,omitempty"`
+	Domain *Reference `json:"domain,omitempty"`
+}
+
+// MappingGroup group by ID or by Name and Domain
+type MappingGroup struct {
+	ID     string     `json:"id,omitempty"`
+	Name   string     `json:"name,omitempty"`
+	Domain *Reference `json:"domain,omitempty"`
+}
+
+// MappingProject project created for the user with the given roles
+type MappingProject struct {
+	Name  string      `json:"name"`
+	Roles []Reference `json:"roles"`
+}
+
+// MappingRemote requirement on an assertion attribute, without AnyOneOf or NotAnyOf the attribute values are mapped
+type MappingRemote struct {
+	Type      string   `json:"type"`
+	AnyOneOf  []string `json:"any_one_of,omitempty"`
+	NotAnyOf  []string `json:"not_any_of,omitempty"`
+	Whitelist []string `json:"whitelist,omitempty"`
+	Blacklist []string `json:"blacklist,omitempty"`
+	Regex     bool     `json:"regex,omitempty"`
+}
+
+// MappedProperties result of evaluating a mapping against an assertion
+type MappedProperties struct {
+	User       MappingUser      `json:"user"`
+	GroupIDs   []string         `json:"group_ids"`
+	GroupNames []MappingGroup   `json:"group_names"`
+	Projects   []MappingProject `json:"projects"`
+}
+
+// ProtocolResponse list protocols type
+type ProtocolResponse struct {
+	Protocols []Protocol `json:"protocols"`
+	Links     links      `json:"links"`
+}
+
+type protocolResponse struct {
+	Protocol Protocol `json:"protocol"`
+}
+
+// Protocol binds an identity provider protocol e.g. openid or saml2 to a mapping
+type Protocol struct {
+	ID                string `json:"id"`
+	MappingID         string `json:"mapping_id"`
+	RemoteIDAttribute string `json:"remote_id_attribute"`
+}
+
+// ProtocolOpts create and update protocol fields
+type ProtocolOpts struct {
+	MappingID         string `json:"mapping_id,omitempty"`
+	RemoteIDAttribute string `json:"remote_id_attribute,omitempty"`
+}
+
+type protocolRequest struct {
+	Protocol ProtocolOpts `json:"protocol"`
+}
+
+// ServiceProviderResponse list service providers type
+type ServiceProviderResponse struct {
+	ServiceProviders []ServiceProvider `json:"service_providers"`
+	Links            links             `json:"links"`
+}
+
+type serviceProviderResponse struct {
+	ServiceProvider ServiceProvider `json:"service_provider"`
+}
+
+// ServiceProvider remote keystone trusting this keystone as identity provider
+type ServiceProvider struct {
+	ID               string `json:"id"`
+	AuthURL          string `json:"auth_url"`
+	SPURL            string `json:"sp_url"`
+	Description      string `json:"description"`
+	Enabled          bool   `json:"enabled"`
+	RelayStatePrefix string `json:"relay_state_prefix"`
+}
+
+// ServiceProviderOpts create and update service provider fields
+type ServiceProviderOpts struct {
+	AuthURL          string `json:"auth_url,omitempty"`
+	SPURL            string `json:"sp_url,omitempty"`
+	Description      string `json:"description,omitempty"`
+	Enabled          *bool  `json:"enabled,omitempty"`
+	RelayStatePrefix string `json:"relay_state_prefix,omitempty"`
+}
+
+type serviceProviderRequest struct {
+	ServiceProvider ServiceProviderOpts `json:"service_provider"`
 }
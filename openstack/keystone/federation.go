@@ -0,0 +1,269 @@
+package keystone
+
+import (
+	"strings"
+)
+
+const identityProvidersPath = "/OS-FEDERATION/identity_providers"
+const identityProviderPath = "/OS-FEDERATION/identity_providers/$id"
+const protocolsPath = "/OS-FEDERATION/identity_providers/$id/protocols"
+const protocolPath = "/OS-FEDERATION/identity_providers/$id/protocols/$protocol"
+const mappingsPath = "/OS-FEDERATION/mappings"
+const mappingPath = "/OS-FEDERATION/mappings/$id"
+const serviceProvidersPath = "/OS-FEDERATION/service_providers"
+const serviceProviderPath = "/OS-FEDERATION/service_providers/$id"
+
+// ListIdentityProviders list all identity providers
+func (k *keystone) ListIdentityProviders() (providers []IdentityProvider, err error) {
+	path := k.identityURL() + identityProvidersPath
+
+	for path != "" {
+		var jsonResponse IdentityProviderResponse
+		err = k.do("GET", path, "ListIdentityProviders", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		providers = append(providers, jsonResponse.IdentityProviders...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetIdentityProvider get identity provider by id
+func (k *keystone) GetIdentityProvider(providerID string) (provider IdentityProvider, err error) {
+	path := k.identityURL() + strings.Replace(identityProviderPath, "$id", providerID, -1)
+
+	var jsonResponse identityProviderResponse
+	err = k.do("GET", path, "GetIdentityProvider", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	provider = jsonResponse.IdentityProvider
+	return
+}
+
+// CreateIdentityProvider register an identity provider under providerID
+func (k *keystone) CreateIdentityProvider(providerID string, opts IdentityProviderOpts) (provider IdentityProvider, err error) {
+	path := k.identityURL() + strings.Replace(identityProviderPath, "$id", providerID, -1)
+
+	var jsonResponse identityProviderResponse
+	err = k.do("PUT", path, "CreateIdentityProvider", identityProviderRequest{IdentityProvider: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	provider = jsonResponse.IdentityProvider
+	return
+}
+
+// UpdateIdentityProvider update only the fields set in opts, the domain can't be changed
+func (k *keystone) UpdateIdentityProvider(providerID string, opts IdentityProviderOpts) (provider IdentityProvider, err error) {
+	path := k.identityURL() + strings.Replace(identityProviderPath, "$id", providerID, -1)
+
+	var jsonResponse identityProviderResponse
+	err = k.do("PATCH", path, "UpdateIdentityProvider", identityProviderRequest{IdentityProvider: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	provider = jsonResponse.IdentityProvider
+	return
+}
+
+// DeleteIdentityProvider delete identity provider by id together with its protocols
+func (k *keystone) DeleteIdentityProvider(providerID string) (err error) {
+	path := k.identityURL() + strings.Replace(identityProviderPath, "$id", providerID, -1)
+	return k.do("DELETE", path, "DeleteIdentityProvider", nil, nil)
+}
+
+// ListMappings list all mappings
+func (k *keystone) ListMappings() (mappings []Mapping, err error) {
+	path := k.identityURL() + mappingsPath
+
+	for path != "" {
+		var jsonResponse MappingResponse
+		err = k.do("GET", path, "ListMappings", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		mappings = append(mappings, jsonResponse.Mappings...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetMapping get mapping by id
+func (k *keystone) GetMapping(mappingID string) (mapping Mapping, err error) {
+	path := k.identityURL() + strings.Replace(mappingPath, "$id", mappingID, -1)
+
+	var jsonResponse mappingResponse
+	err = k.do("GET", path, "GetMapping", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	mapping = jsonResponse.Mapping
+	return
+}
+
+// CreateMapping create a mapping under mappingID, use EvaluateMapping to try the rules first
+func (k *keystone) CreateMapping(mappingID string, opts MappingOpts) (mapping Mapping, err error) {
+	path := k.identityURL() + strings.Replace(mappingPath, "$id", mappingID, -1)
+
+	var jsonResponse mappingResponse
+	err = k.do("PUT", path, "CreateMapping", mappingRequest{Mapping: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	mapping = jsonResponse.Mapping
+	return
+}
+
+// UpdateMapping replace the mapping rules
+func (k *keystone) UpdateMapping(mappingID string, opts MappingOpts) (mapping Mapping, err error) {
+	path := k.identityURL() + strings.Replace(mappingPath, "$id", mappingID, -1)
+
+	var jsonResponse mappingResponse
+	err = k.do("PATCH", path, "UpdateMapping", mappingRequest{Mapping: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	mapping = jsonResponse.Mapping
+	return
+}
+
+// DeleteMapping delete mapping by id
+func (k *keystone) DeleteMapping(mappingID string) (err error) {
+	path := k.identityURL() + strings.Replace(mappingPath, "$id", mappingID, -1)
+	return k.do("DELETE", path, "DeleteMapping", nil, nil)
+}
+
+// ListProtocols list the protocols of an identity provider
+func (k *keystone) ListProtocols(providerID string) (protocols []Protocol, err error) {
+	path := k.identityURL() + strings.Replace(protocolsPath, "$id", providerID, -1)
+
+	var jsonResponse ProtocolResponse
+	err = k.do("GET", path, "ListProtocols", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	protocols = jsonResponse.Protocols
+	return
+}
+
+// GetProtocol get an identity provider protocol
+func (k *keystone) GetProtocol(providerID, protocolID string) (protocol Protocol, err error) {
+	var jsonResponse protocolResponse
+	err = k.do("GET", k.protocolPath(providerID, protocolID), "GetProtocol", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	protocol = jsonResponse.Protocol
+	return
+}
+
+// CreateProtocol add a protocol to the identity provider using the mapping in opts
+func (k *keystone) CreateProtocol(providerID, protocolID string, opts ProtocolOpts) (protocol Protocol, err error) {
+	var jsonResponse protocolResponse
+	err = k.do("PUT", k.protocolPath(providerID, protocolID), "CreateProtocol", protocolRequest{Protocol: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	protocol = jsonResponse.Protocol
+	return
+}
+
+// UpdateProtocol update only the fields set in opts
+func (k *keystone) UpdateProtocol(providerID, protocolID string, opts ProtocolOpts) (protocol Protocol, err error) {
+	var jsonResponse protocolResponse
+	err = k.do("PATCH", k.protocolPath(providerID, protocolID), "UpdateProtocol", protocolRequest{Protocol: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	protocol = jsonResponse.Protocol
+	return
+}
+
+// DeleteProtocol remove the protocol from the identity provider
+func (k *keystone) DeleteProtocol(providerID, protocolID string) (err error) {
+	return k.do("DELETE", k.protocolPath(providerID, protocolID), "DeleteProtocol", nil, nil)
+}
+
+func (k *keystone) protocolPath(providerID, protocolID string) string {
+	path := strings.Replace(protocolPath, "$id", providerID, -1)
+	return k.identityURL() + strings.Replace(path, "$protocol", protocolID, -1)
+}
+
+// ListServiceProviders list all service providers
+func (k *keystone) ListServiceProviders() (providers []ServiceProvider, err error) {
+	path := k.identityURL() + serviceProvidersPath
+
+	for path != "" {
+		var jsonResponse ServiceProviderResponse
+		err = k.do("GET", path, "ListServiceProviders", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		providers = append(providers, jsonResponse.ServiceProviders...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetServiceProvider get service provider by id
+func (k *keystone) GetServiceProvider(providerID string) (provider ServiceProvider, err error) {
+	path := k.identityURL() + strings.Replace(serviceProviderPath, "$id", providerID, -1)
+
+	var jsonResponse serviceProviderResponse
+	err = k.do("GET", path, "GetServiceProvider", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	provider = jsonResponse.ServiceProvider
+	return
+}
+
+// CreateServiceProvider register a service provider under providerID
+func (k *keystone) CreateServiceProvider(providerID string, opts ServiceProviderOpts) (provider ServiceProvider, err error) {
+	path := k.identityURL() + strings.Replace(serviceProviderPath, "$id", providerID, -1)
+
+	var jsonResponse serviceProviderResponse
+	err = k.do("PUT", path, "CreateServiceProvider", serviceProviderRequest{ServiceProvider: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	provider = jsonResponse.ServiceProvider
+	return
+}
+
+// UpdateServiceProvider update only the fields set in opts
+func (k *keystone) UpdateServiceProvider(providerID string, opts ServiceProviderOpts) (provider ServiceProvider, err error) {
+	path := k.identityURL() + strings.Replace(serviceProviderPath, "$id", providerID, -1)
+
+	var jsonResponse serviceProviderResponse
+	err = k.do("PATCH", path, "UpdateServiceProvider", serviceProviderRequest{ServiceProvider: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	provider = jsonResponse.ServiceProvider
+	return
+}
+
+// DeleteServiceProvider delete service provider by id
+func (k *keystone) DeleteServiceProvider(providerID string) (err error) {
+	path := k.identityURL() + strings.Replace(serviceProviderPath, "$id", providerID, -1)
+	return k.do("DELETE", path, "DeleteServiceProvider", nil, nil)
+}
@@ -0,0 +1,138 @@
+package keystone
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Buni/openstack-client/openstack/client"
+)
+
+const groupsPath = "/groups"
+const groupPath = "/groups/$id"
+const groupUsersPath = "/groups/$id/users"
+const groupUserPath = "/groups/$id/users/$user"
+
+// ListGroups list groups matching opts, following the next links when keystone paginates the response
+func (k *keystone) ListGroups(opts ListGroupsOpts) (groups []Group, err error) {
+	path := k.identityURL() + groupsPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse GroupResponse
+		err = k.do("GET", path, "ListGroups", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		groups = append(groups, jsonResponse.Groups...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetGroup get group by id
+func (k *keystone) GetGroup(groupID string) (group Group, err error) {
+	path := k.identityURL() + strings.Replace(groupPath, "$id", groupID, -1)
+
+	var jsonResponse groupResponse
+	err = k.do("GET", path, "GetGroup", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	group = jsonResponse.Group
+	return
+}
+
+// CreateGroup create a group
+func (k *keystone) CreateGroup(opts GroupOpts) (group Group, err error) {
+	path := k.identityURL() + groupsPath
+
+	var jsonResponse groupResponse
+	err = k.do("POST", path, "CreateGroup", groupRequest{Group: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	group = jsonResponse.Group
+	return
+}
+
+// UpdateGroup update only the fields set in opts
+func (k *keystone) UpdateGroup(groupID string, opts GroupOpts) (group Group, err error) {
+	path := k.identityURL() + strings.Replace(groupPath, "$id", groupID, -1)
+
+	var jsonResponse groupResponse
+	err = k.do("PATCH", path, "UpdateGroup", groupRequest{Group: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	group = jsonResponse.Group
+	return
+}
+
+// DeleteGroup delete group by id
+func (k *keystone) DeleteGroup(groupID string) (err error) {
+	path := k.identityURL() + strings.Replace(groupPath, "$id", groupID, -1)
+	return k.do("DELETE", path, "DeleteGroup", nil, nil)
+}
+
+// ListGroupUsers list the members of the group
+func (k *keystone) ListGroupUsers(groupID string) (users []User, err error) {
+	path := k.identityURL() + strings.Replace(groupUsersPath, "$id", groupID, -1)
+
+	var jsonResponse UserResponse
+	err = k.do("GET", path, "ListGroupUsers", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	users = jsonResponse.Users
+	return
+}
+
+// AddUserToGroup add user to group
+func (k *keystone) AddUserToGroup(groupID, userID string) (err error) {
+	return k.do("PUT", k.groupUserPath(groupID, userID), "AddUserToGroup", nil, nil)
+}
+
+// RemoveUserFromGroup remove user from group
+func (k *keystone) RemoveUserFromGroup(groupID, userID string) (err error) {
+	return k.do("DELETE", k.groupUserPath(groupID, userID), "RemoveUserFromGroup", nil, nil)
+}
+
+// CheckUserInGroup check whether the user is a member of the group
+func (k *keystone) CheckUserInGroup(groupID, userID string) (ok bool, err error) {
+	err = k.do("HEAD", k.groupUserPath(groupID, userID), "CheckUserInGroup", nil, nil)
+	if client.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (k *keystone) groupUserPath(groupID, userID string) string {
+	path := strings.Replace(groupUserPath, "$id", groupID, -1)
+	return k.identityURL() + strings.Replace(path, "$user", userID, -1)
+}
+
+func (o ListGroupsOpts) query() string {
+	query := url.Values{}
+	if o.DomainID != "" {
+		query.Set("domain_id", o.DomainID)
+	}
+	if o.Name != "" {
+		query.Set("name", o.Name)
+	}
+	if o.Marker != "" {
+		query.Set("marker", o.Marker)
+	}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return query.Encode()
+}
@@ -5,7 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
 	"sync"
 	"time"
 
@@ -20,8 +24,148 @@ type Keystone interface {
 	GetToken() string
 	GetEndpoint(name string) string
 	GetClient() client.Client
+	Rescope(projectID string) (rescoped Keystone, err error)
+	Passcode(supplier PasscodeSupplier)
+	Cache(cache TokenCache)
+
+	ListProjects(opts ListProjectsOpts) (projects []Project, err error)
+	ListUserProjects(userID string) (projects []Project, err error)
+	GetProject(projectID string) (project Project, err error)
+	CreateProject(opts ProjectOpts) (project Project, err error)
+	UpdateProject(projectID string, opts ProjectOpts) (project Project, err error)
+	DeleteProject(projectID string) (err error)
+	ListProjectTags(projectID string) (tags []string, err error)
+	ReplaceProjectTags(projectID string, tags []string) (err error)
+	AddProjectTag(projectID, tag string) (err error)
+	CheckProjectTag(projectID, tag string) (ok bool, err error)
+	DeleteProjectTag(projectID, tag string) (err error)
+	DeleteProjectTags(projectID string) (err error)
+
+	ListUsers(opts ListUsersOpts) (users []User, err error)
+	GetUser(userID string) (user User, err error)
+	CreateUser(opts UserOpts) (user User, err error)
+	UpdateUser(userID string, opts UserOpts) (user User, err error)
+	DeleteUser(userID string) (err error)
+	ChangePassword(userID, originalPassword, password string) (err error)
+	ListUserGroups(userID string) (groups []Group, err error)
+
+	ListGroups(opts ListGroupsOpts) (groups []Group, err error)
+	GetGroup(groupID string) (group Group, err error)
+	CreateGroup(opts GroupOpts) (group Group, err error)
+	UpdateGroup(groupID string, opts GroupOpts) (group Group, err error)
+	DeleteGroup(groupID string) (err error)
+	ListGroupUsers(groupID string) (users []User, err error)
+	AddUserToGroup(groupID, userID string) (err error)
+	RemoveUserFromGroup(groupID, userID string) (err error)
+	CheckUserInGroup(groupID, userID string) (ok bool, err error)
+
+	ListRoles(opts ListRolesOpts) (roles []Role, err error)
+	GetRole(roleID string) (role Role, err error)
+	CreateRole(opts RoleOpts) (role Role, err error)
+	UpdateRole(roleID string, opts RoleOpts) (role Role, err error)
+	DeleteRole(roleID string) (err error)
+	GrantRole(grant RoleGrant) (err error)
+	RevokeRole(grant RoleGrant) (err error)
+	CheckRole(grant RoleGrant) (ok bool, err error)
+	ListGrantedRoles(grant RoleGrant) (roles []Role, err error)
+	ListRoleAssignments(opts ListRoleAssignmentsOpts) (assignments []RoleAssignment, err error)
+	CreateImpliedRole(priorRoleID, impliedRoleID string) (err error)
+	CheckImpliedRole(priorRoleID, impliedRoleID string) (ok bool, err error)
+	DeleteImpliedRole(priorRoleID, impliedRoleID string) (err error)
+	ListImpliedRoles(priorRoleID string) (inference RoleInference, err error)
+	ListRoleInferences() (inferences []RoleInference, err error)
+
+	ListDomains(opts ListDomainsOpts) (domains []Domain, err error)
+	GetDomain(domainID string) (domain Domain, err error)
+	CreateDomain(opts DomainOpts) (domain Domain, err error)
+	UpdateDomain(domainID string, opts DomainOpts) (domain Domain, err error)
+	DeleteDomain(domainID string) (err error)
+
+	ListRegions(parentRegionID string) (regions []Region, err error)
+	GetRegion(regionID string) (region Region, err error)
+	CreateRegion(opts RegionOpts) (region Region, err error)
+	UpdateRegion(regionID string, opts RegionOpts) (region Region, err error)
+	DeleteRegion(regionID string) (err error)
+
+	ListServices(serviceType string) (services []Service, err error)
+	GetService(serviceID string) (service Service, err error)
+	CreateService(opts ServiceOpts) (service Service, err error)
+	UpdateService(serviceID string, opts ServiceOpts) (service Service, err error)
+	DeleteService(serviceID string) (err error)
+
+	ListEndpoints(opts ListEndpointsOpts) (endpoints []Endpoint, err error)
+	GetEndpointByID(endpointID string) (endpoint Endpoint, err error)
+	CreateEndpoint(opts EndpointOpts) (endpoint Endpoint, err error)
+	UpdateEndpoint(endpointID string, opts EndpointOpts) (endpoint Endpoint, err error)
+	DeleteEndpoint(endpointID string) (err error)
+
+	ValidateToken(subjectToken string, opts ValidateTokenOpts) (info TokenInfo, err error)
+	CheckToken(subjectToken string) (ok bool, err error)
+	RevokeToken(subjectToken string) (err error)
+
+	ListTrusts(opts ListTrustsOpts) (trusts []Trust, err error)
+	GetTrust(trustID string) (trust Trust, err error)
+	CreateTrust(opts TrustOpts) (trust Trust, err error)
+	DeleteTrust(trustID string) (err error)
+	ListTrustRoles(trustID string) (roles []Role, err error)
+	CheckTrustRole(trustID, roleID string) (ok bool, err error)
+
+	ListApplicationCredentials(userID, name string) (credentials []ApplicationCredential, err error)
+	GetApplicationCredential(userID, credentialID string) (credential ApplicationCredential, err error)
+	CreateApplicationCredential(userID string, opts ApplicationCredentialOpts) (credential ApplicationCredential, err error)
+	DeleteApplicationCredential(userID, credentialID string) (err error)
+
+	ListEC2Credentials(userID string) (credentials []EC2Credential, err error)
+	GetEC2Credential(userID, access string) (credential EC2Credential, err error)
+	CreateEC2Credential(userID, projectID string) (credential EC2Credential, err error)
+	DeleteEC2Credential(userID, access string) (err error)
+
+	ListCredentials(opts ListCredentialsOpts) (credentials []Credential, err error)
+	GetCredential(credentialID string) (credential Credential, err error)
+	CreateCredential(opts CredentialOpts) (credential Credential, err error)
+	UpdateCredential(credentialID string, opts CredentialOpts) (credential Credential, err error)
+	DeleteCredential(credentialID string) (err error)
+
+	ListRegisteredLimits(opts ListLimitsOpts) (limits []RegisteredLimit, err error)
+	GetRegisteredLimit(limitID string) (limit RegisteredLimit, err error)
+	CreateRegisteredLimits(opts []RegisteredLimitOpts) (limits []RegisteredLimit, err error)
+	UpdateRegisteredLimit(limitID string, opts RegisteredLimitOpts) (limit RegisteredLimit, err error)
+	DeleteRegisteredLimit(limitID string) (err error)
+	ListLimits(opts ListLimitsOpts) (limits []Limit, err error)
+	GetLimit(limitID string) (limit Limit, err error)
+	CreateLimits(opts []LimitOpts) (limits []Limit, err error)
+	UpdateLimit(limitID string, opts LimitOpts) (limit Limit, err error)
+	DeleteLimit(limitID string) (err error)
+	GetLimitModel() (model LimitModel, err error)
+	EffectiveLimit(projectID, serviceID, regionID, resourceName string) (limit int, err error)
+	CheckLimit(opts CheckLimitOpts) (err error)
+
+	ListIdentityProviders() (providers []IdentityProvider, err error)
+	GetIdentityProvider(providerID string) (provider IdentityProvider, err error)
+	CreateIdentityProvider(providerID string, opts IdentityProviderOpts) (provider IdentityProvider, err error)
+	UpdateIdentityProvider(providerID string, opts IdentityProviderOpts) (provider IdentityProvider, err error)
+	DeleteIdentityProvider(providerID string) (err error)
+	ListMappings() (mappings []Mapping, err error)
+	GetMapping(mappingID string) (mapping Mapping, err error)
+	CreateMapping(mappingID string, opts MappingOpts) (mapping Mapping, err error)
+	UpdateMapping(mappingID string, opts MappingOpts) (mapping Mapping, err error)
+	DeleteMapping(mappingID string) (err error)
+	ListProtocols(providerID string) (protocols []Protocol, err error)
+	GetProtocol(providerID, protocolID string) (protocol Protocol, err error)
+	CreateProtocol(providerID, protocolID string, opts ProtocolOpts) (protocol Protocol, err error)
+	UpdateProtocol(providerID, protocolID string, opts ProtocolOpts) (protocol Protocol, err error)
+	DeleteProtocol(providerID, protocolID string) (err error)
+	ListServiceProviders() (providers []ServiceProvider, err error)
+	GetServiceProvider(providerID string) (provider ServiceProvider, err error)
+	CreateServiceProvider(providerID string, opts ServiceProviderOpts) (provider ServiceProvider, err error)
+	UpdateServiceProvider(providerID string, opts ServiceProviderOpts) (provider ServiceProvider, err error)
+	DeleteServiceProvider(providerID string) (err error)
 }
 
+const tokensPath = "/auth/tokens"
+const subjectTokenHeader = "X-Subject-Token"
+const receiptHeader = "Openstack-Auth-Receipt"
+
 // keystone Auth
 type keystone struct {
 	Auth      authScoped        `json:"auth"`
@@ -29,13 +173,26 @@ type keystone struct {
 	Endpoint  string            `json:"-"`
 	Endpoints map[string]string `json:"-"`
 	client    client.Client
+	passcode  PasscodeSupplier
+	oidc      *OIDCOptions
+	ec2       *ec2Keys
+	cache     TokenCache
 	updated   time.Time
 	mux       *sync.Mutex
 }
 
 // New instance of keystone
 func New(methods []string, name, dom, pass, endpoint string) Keystone {
-	auth := authScoped{Identity: identity{Methods: methods, Password: password{user{Name: name, Domain: domain{ID: dom}, Password: pass}}}, Scope: scope{Project: project{Name: name, Domain: domain{ID: dom}}}}
+	auth := authScoped{Identity: identity{Methods: methods, Password: &password{user{Name: name, Domain: domain{ID: dom}, Password: pass}}}, Scope: &scope{Project: &project{Name: name, Domain: &domain{ID: dom}}}}
+	return newClient(&keystone{Auth: auth, Token: token{}, Endpoint: endpoint, Endpoints: make(map[string]string), mux: new(sync.Mutex)})
+}
+
+// NewFromToken instance of keystone authenticating with the token method, an empty projectID requests an unscoped token
+func NewFromToken(tokenID, projectID, endpoint string) Keystone {
+	auth := authScoped{Identity: identity{Methods: []string{"token"}, Token: &tokenMethod{ID: tokenID}}}
+	if projectID != "" {
+		auth.Scope = &scope{Project: &project{ID: projectID}}
+	}
 	return newClient(&keystone{Auth: auth, Token: token{}, Endpoint: endpoint, Endpoints: make(map[string]string), mux: new(sync.Mutex)})
 }
 
@@ -53,7 +210,42 @@ func (k *keystone) Authenticate() (err error) {
 	k.mux.Lock()
 
 	defer k.mux.Unlock()
-	var jsonResponse resp
+	if k.cache != nil && k.restoreToken() {
+		return
+	}
+	return k.authenticate()
+}
+
+// authenticate expects k.mux to be held by the caller, the cache is updated but never read
+func (k *keystone) authenticate() (err error) {
+	err = k.login()
+	if err == nil && k.cache != nil {
+		k.storeToken()
+	}
+	return
+}
+
+// login pick the auth flow, expects k.mux to be held by the caller
+func (k *keystone) login() (err error) {
+	if k.ec2 != nil {
+		return k.authenticateEC2()
+	}
+	if k.oidc != nil {
+		err = k.authenticateOIDC()
+		if err != nil || k.Auth.Scope == nil {
+			return
+		}
+	}
+
+	k.Auth.Identity.TOTP = nil
+	for _, method := range k.Auth.Identity.Methods {
+		if method == "totp" {
+			k.Auth.Identity.TOTP, err = k.totp()
+			if err != nil {
+				return
+			}
+		}
+	}
 
 	payload, err := json.Marshal(k)
 	if err != nil {
@@ -61,13 +253,23 @@ func (k *keystone) Authenticate() (err error) {
 	}
 
 	resp, err := k.client.DoRequest(context.TODO(), "POST", k.Endpoint, bytes.NewBuffer(payload))
+	if receipt := authReceipt(err); receipt != "" && k.passcode != nil {
+		resp, err = k.authenticateReceipt(receipt)
+	}
 	if err != nil {
 		return
 	}
 
-	defer resp.Body.Close()
+	return k.setToken(resp)
+}
 
-	respBody, err := ioutil.ReadAll(resp.Body)
+// setToken store the token and public endpoints of an authentication response
+func (k *keystone) setToken(response *http.Response) (err error) {
+	var jsonResponse resp
+
+	defer response.Body.Close()
+
+	respBody, err := ioutil.ReadAll(response.Body)
 	if err != nil {
 		return
 	}
@@ -80,7 +282,7 @@ func (k *keystone) Authenticate() (err error) {
 	}
 	// c.parseEndpoints(jsonResponse, "public")
 	// c.parseEndpoints(jsonResponse, "internal")
-	k.Token.Value = resp.Header.Get("X-Subject-Token")
+	k.Token.Value = response.Header.Get(subjectTokenHeader)
 	k.Token.ExperiesAt = jsonResponse.Token.ExpiresAt
 	k.Token.ProjectID = jsonResponse.Token.Project.ID
 
@@ -105,7 +307,7 @@ func (k *keystone) ReAuthenticate() (err error) {
 		return errors.New("token was updated less than a minute ago")
 	}
 
-	err = k.Authenticate()
+	err = k.authenticate()
 	if err != nil {
 		return
 	}
@@ -114,6 +316,76 @@ func (k *keystone) ReAuthenticate() (err error) {
 	return
 }
 
+// Passcode set the TOTP passcode supplier, it is used for the totp method and to answer MFA auth receipts
+func (k *keystone) Passcode(supplier PasscodeSupplier) {
+	k.mux.Lock()
+	defer k.mux.Unlock()
+	k.passcode = supplier
+}
+
+// Cache set a token cache, Authenticate restores still valid tokens from it and every new token is stored in it
+func (k *keystone) Cache(cache TokenCache) {
+	k.mux.Lock()
+	defer k.mux.Unlock()
+	k.cache = cache
+}
+
+// authenticateReceipt complete a multi factor authentication, keystone accepted the first methods and returned a receipt
+func (k *keystone) authenticateReceipt(receipt string) (resp *http.Response, err error) {
+	totp, err := k.totp()
+	if err != nil {
+		return
+	}
+
+	auth := authScoped{Identity: identity{Methods: []string{"totp"}, TOTP: totp}, Scope: k.Auth.Scope}
+	payload, err := json.Marshal(struct {
+		Auth authScoped `json:"auth"`
+	}{Auth: auth})
+	if err != nil {
+		return
+	}
+
+	return k.client.NewRequest(k.Endpoint, "POST", bytes.NewBuffer(payload)).Header(receiptHeader, receipt).MetaData("keystone", "Authenticate").DoNonAuth()
+}
+
+// totp build the totp method payload for the password user with a fresh passcode
+func (k *keystone) totp() (method *totpMethod, err error) {
+	if k.passcode == nil {
+		return nil, errors.New("totp authentication requires a passcode supplier")
+	}
+	if k.Auth.Identity.Password == nil {
+		return nil, errors.New("totp authentication requires the password method user")
+	}
+
+	passcode, err := k.passcode()
+	if err != nil {
+		return
+	}
+
+	method = &totpMethod{}
+	method.User.Name = k.Auth.Identity.Password.User.Name
+	method.User.Domain = k.Auth.Identity.Password.User.Domain
+	method.User.Passcode = passcode
+	return
+}
+
+// authReceipt returns the auth receipt of a 401 response, keystone sends one when more auth methods are required
+func authReceipt(err error) string {
+	respErr, ok := err.(*client.ResponseError)
+	if !ok || respErr.StatusCode != http.StatusUnauthorized {
+		return ""
+	}
+	return respErr.Header.Get(receiptHeader)
+}
+
+// Rescope exchange the current token for a token scoped to projectID, the returned Keystone has its own client and catalog
+// it re-authenticates with the original token so it is only usable until that token expires
+func (k *keystone) Rescope(projectID string) (rescoped Keystone, err error) {
+	rescoped = NewFromToken(k.GetToken(), projectID, k.Endpoint)
+	err = rescoped.Authenticate()
+	return
+}
+
 // GetToken returns the currently set keystone token
 func (k *keystone) GetToken() string {
 	k.mux.Lock()
@@ -127,3 +399,54 @@ func (k *keystone) GetEndpoint(name string) string {
 	defer k.mux.Unlock()
 	return k.Endpoints[name]
 }
+
+// identityURL the versioned identity endpoint e.g. http://keystone/identity/v3 derived from the auth url
+func (k *keystone) identityURL() string {
+	return strings.TrimSuffix(k.Endpoint, tokensPath)
+}
+
+// do marshal in as the request body, execute the request and unmarshal the response into out, both in and out can be nil
+func (k *keystone) do(method, path, operation string, in, out interface{}) (err error) {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewBuffer(payload)
+	}
+
+	_, err = k.exec(k.client.NewRequest(path, method, body).MetaData("keystone", operation), out)
+	return
+}
+
+// exec execute an already prepared request, the returned response body is already closed
+func (k *keystone) exec(req *client.Request, out interface{}) (resp *http.Response, err error) {
+	resp, err = req.Context(context.TODO()).Do()
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+	log.Debugln(redact(respBody))
+
+	if out == nil || len(respBody) == 0 {
+		return
+	}
+
+	err = json.Unmarshal(respBody, out)
+	return
+}
+
+// secretFields json string values which must never end up in the logs, e.g. the application credential secret
+// which keystone only returns once on creation and the credential blob holding the EC2 secret key
+var secretFields = regexp.MustCompile(`("(?:secret|blob)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// redact the response body for logging
+func redact(body []byte) string {
+	return secretFields.ReplaceAllString(string(body), `$1"***"`)
+}
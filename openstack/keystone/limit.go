@@ -0,0 +1,272 @@
+package keystone
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+const registeredLimitsPath = "/registered_limits"
+const registeredLimitPath = "/registered_limits/$id"
+const limitsPath = "/limits"
+const limitPath = "/limits/$id"
+const limitModelPath = "/limits/model"
+
+const strictTwoLevel = "strict-two-level"
+
+// ListRegisteredLimits list registered limits matching opts
+func (k *keystone) ListRegisteredLimits(opts ListLimitsOpts) (limits []RegisteredLimit, err error) {
+	opts.ProjectID, opts.DomainID = "", ""
+	path := k.identityURL() + registeredLimitsPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse RegisteredLimitResponse
+		err = k.do("GET", path, "ListRegisteredLimits", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		limits = append(limits, jsonResponse.RegisteredLimits...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetRegisteredLimit get registered limit by id
+func (k *keystone) GetRegisteredLimit(limitID string) (limit RegisteredLimit, err error) {
+	path := k.identityURL() + strings.Replace(registeredLimitPath, "$id", limitID, -1)
+
+	var jsonResponse registeredLimitResponse
+	err = k.do("GET", path, "GetRegisteredLimit", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	limit = jsonResponse.RegisteredLimit
+	return
+}
+
+// CreateRegisteredLimits create registered limits in one batch, either all or none are created
+func (k *keystone) CreateRegisteredLimits(opts []RegisteredLimitOpts) (limits []RegisteredLimit, err error) {
+	path := k.identityURL() + registeredLimitsPath
+
+	var jsonResponse RegisteredLimitResponse
+	err = k.do("POST", path, "CreateRegisteredLimits", registeredLimitsRequest{RegisteredLimits: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	limits = jsonResponse.RegisteredLimits
+	return
+}
+
+// UpdateRegisteredLimit update only the fields set in opts
+func (k *keystone) UpdateRegisteredLimit(limitID string, opts RegisteredLimitOpts) (limit RegisteredLimit, err error) {
+	path := k.identityURL() + strings.Replace(registeredLimitPath, "$id", limitID, -1)
+
+	var jsonResponse registeredLimitResponse
+	err = k.do("PATCH", path, "UpdateRegisteredLimit", registeredLimitRequest{RegisteredLimit: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	limit = jsonResponse.RegisteredLimit
+	return
+}
+
+// DeleteRegisteredLimit delete registered limit by id
+func (k *keystone) DeleteRegisteredLimit(limitID string) (err error) {
+	path := k.identityURL() + strings.Replace(registeredLimitPath, "$id", limitID, -1)
+	return k.do("DELETE", path, "DeleteRegisteredLimit", nil, nil)
+}
+
+// ListLimits list project and domain limits matching opts
+func (k *keystone) ListLimits(opts ListLimitsOpts) (limits []Limit, err error) {
+	path := k.identityURL() + limitsPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse LimitResponse
+		err = k.do("GET", path, "ListLimits", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		limits = append(limits, jsonResponse.Limits...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetLimit get limit by id
+func (k *keystone) GetLimit(limitID string) (limit Limit, err error) {
+	path := k.identityURL() + strings.Replace(limitPath, "$id", limitID, -1)
+
+	var jsonResponse limitResponse
+	err = k.do("GET", path, "GetLimit", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	limit = jsonResponse.Limit
+	return
+}
+
+// CreateLimits create project or domain limits in one batch, every limit needs a registered limit
+func (k *keystone) CreateLimits(opts []LimitOpts) (limits []Limit, err error) {
+	path := k.identityURL() + limitsPath
+
+	var jsonResponse LimitResponse
+	err = k.do("POST", path, "CreateLimits", limitsRequest{Limits: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	limits = jsonResponse.Limits
+	return
+}
+
+// UpdateLimit update the resource limit or description
+func (k *keystone) UpdateLimit(limitID string, opts LimitOpts) (limit Limit, err error) {
+	path := k.identityURL() + strings.Replace(limitPath, "$id", limitID, -1)
+
+	var jsonResponse limitResponse
+	err = k.do("PATCH", path, "UpdateLimit", limitRequest{Limit: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	limit = jsonResponse.Limit
+	return
+}
+
+// DeleteLimit delete limit by id
+func (k *keystone) DeleteLimit(limitID string) (err error) {
+	path := k.identityURL() + strings.Replace(limitPath, "$id", limitID, -1)
+	return k.do("DELETE", path, "DeleteLimit", nil, nil)
+}
+
+// GetLimitModel get the enforcement model configured in keystone
+func (k *keystone) GetLimitModel() (model LimitModel, err error) {
+	path := k.identityURL() + limitModelPath
+
+	var jsonResponse limitModelResponse
+	err = k.do("GET", path, "GetLimitModel", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	model = jsonResponse.Model
+	return
+}
+
+// EffectiveLimit the project limit of the resource or the registered default when the project has no limit of its own
+func (k *keystone) EffectiveLimit(projectID, serviceID, regionID, resourceName string) (limit int, err error) {
+	opts := ListLimitsOpts{ProjectID: projectID, ServiceID: serviceID, RegionID: regionID, ResourceName: resourceName}
+	limits, err := k.ListLimits(opts)
+	if err != nil {
+		return
+	}
+	if len(limits) > 0 {
+		return limits[0].ResourceLimit, nil
+	}
+
+	registered, err := k.ListRegisteredLimits(opts)
+	if err != nil {
+		return
+	}
+	if len(registered) == 0 {
+		return 0, errors.New("no registered limit for " + resourceName)
+	}
+
+	return registered[0].DefaultLimit, nil
+}
+
+// CheckLimit check that the project can consume Delta more of the resource, returns a *LimitExceededError when it can't
+// with the strict-two-level model the whole tree under the top level project must also stay within the top level limit
+func (k *keystone) CheckLimit(opts CheckLimitOpts) (err error) {
+	if opts.Usage == nil {
+		return errors.New("limit check requires a usage function")
+	}
+
+	err = k.checkProjectLimit(opts, opts.ProjectID, []string{opts.ProjectID})
+	if err != nil {
+		return
+	}
+
+	model, err := k.GetLimitModel()
+	if err != nil || model.Name != strictTwoLevel {
+		return
+	}
+
+	project, err := k.GetProject(opts.ProjectID)
+	if err != nil {
+		return
+	}
+
+	// a project directly under its domain is the top of its tree
+	root := opts.ProjectID
+	if project.ParentID != "" && project.ParentID != project.DomainID {
+		root = project.ParentID
+	}
+
+	children, err := k.ListProjects(ListProjectsOpts{ParentID: root})
+	if err != nil {
+		return
+	}
+
+	tree := []string{root}
+	for _, child := range children {
+		tree = append(tree, child.ID)
+	}
+
+	return k.checkProjectLimit(opts, root, tree)
+}
+
+// checkProjectLimit compare the effective limit of limitProjectID with the usage of all projects plus the delta
+func (k *keystone) checkProjectLimit(opts CheckLimitOpts, limitProjectID string, projects []string) (err error) {
+	limit, err := k.EffectiveLimit(limitProjectID, opts.ServiceID, opts.RegionID, opts.ResourceName)
+	if err != nil {
+		return
+	}
+
+	total := 0
+	for _, projectID := range projects {
+		usage, err := opts.Usage(projectID)
+		if err != nil {
+			return err
+		}
+		total += usage
+	}
+
+	if total+opts.Delta > limit {
+		return &LimitExceededError{ProjectID: limitProjectID, ResourceName: opts.ResourceName, Limit: limit, Usage: total, Delta: opts.Delta}
+	}
+
+	return
+}
+
+func (o ListLimitsOpts) query() string {
+	query := url.Values{}
+	if o.ServiceID != "" {
+		query.Set("service_id", o.ServiceID)
+	}
+	if o.RegionID != "" {
+		query.Set("region_id", o.RegionID)
+	}
+	if o.ResourceName != "" {
+		query.Set("resource_name", o.ResourceName)
+	}
+	if o.ProjectID != "" {
+		query.Set("project_id", o.ProjectID)
+	}
+	if o.DomainID != "" {
+		query.Set("domain_id", o.DomainID)
+	}
+	return query.Encode()
+}
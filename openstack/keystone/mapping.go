@@ -0,0 +1,257 @@
+package keystone
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// EphemeralUser federated user which exists only for the duration of the token
+	EphemeralUser = "ephemeral"
+	// LocalUser federated user mapped to an existing keystone user
+	LocalUser = "local"
+)
+
+var mappingIndex = regexp.MustCompile(`\{(\d+)\}`)
+
+// EvaluateMapping apply the mapping rules to the assertion the same way keystone-manage mapping_engine does,
+// assertion values holding several entries are separated by ';' e.g. "groups": "admins;devs"
+func EvaluateMapping(rules []MappingRule, assertion map[string]string) (mapped MappedProperties, err error) {
+	values := make(map[string][]string, len(assertion))
+	for name, value := range assertion {
+		values[name] = strings.Split(value, ";")
+	}
+
+	for _, rule := range rules {
+		var directMaps [][]string
+		var ok bool
+		directMaps, ok, err = matchRemote(rule.Remote, values)
+		if err != nil {
+			return
+		}
+		if !ok {
+			continue
+		}
+
+		for _, local := range rule.Local {
+			err = applyLocal(local, directMaps, &mapped)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	if mapped.User.Name != "" || mapped.User.ID != "" {
+		if mapped.User.Type == "" {
+			mapped.User.Type = EphemeralUser
+		}
+		if mapped.User.ID == "" {
+			mapped.User.ID = mapped.User.Name
+		}
+		if mapped.User.Name == "" {
+			mapped.User.Name = mapped.User.ID
+		}
+	}
+	mapped.GroupIDs = unique(mapped.GroupIDs)
+	return
+}
+
+// matchRemote returns the values of the remote types without any_one_of and not_any_of, ok is false when a requirement fails
+func matchRemote(remotes []MappingRemote, assertion map[string][]string) (directMaps [][]string, ok bool, err error) {
+	for _, remote := range remotes {
+		values := assertion[remote.Type]
+		if len(values) == 0 {
+			return
+		}
+
+		if remote.AnyOneOf != nil || remote.NotAnyOf != nil {
+			var matched bool
+			if remote.AnyOneOf != nil {
+				matched, err = matchAny(remote.AnyOneOf, values, remote.Regex)
+			} else {
+				matched, err = matchAny(remote.NotAnyOf, values, remote.Regex)
+				matched = !matched
+			}
+			if err != nil || !matched {
+				return
+			}
+			continue
+		}
+
+		if remote.Blacklist != nil {
+			values, err = filter(values, remote.Blacklist, false, remote.Regex)
+		} else if remote.Whitelist != nil {
+			values, err = filter(values, remote.Whitelist, true, remote.Regex)
+		}
+		if err != nil {
+			return
+		}
+		directMaps = append(directMaps, values)
+	}
+
+	ok = true
+	return
+}
+
+func matchAny(patterns, values []string, regex bool) (bool, error) {
+	for _, pattern := range patterns {
+		var re *regexp.Regexp
+		if regex {
+			var err error
+			re, err = regexp.Compile(pattern)
+			if err != nil {
+				return false, err
+			}
+		}
+		for _, value := range values {
+			if (re != nil && re.MatchString(value)) || (re == nil && pattern == value) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// filter keeps the values matching list for whitelists and the values not matching it for blacklists
+func filter(values, list []string, keep, regex bool) (filtered []string, err error) {
+	for _, value := range values {
+		var matched bool
+		matched, err = matchAny(list, []string{value}, regex)
+		if err != nil {
+			return
+		}
+		if matched == keep {
+			filtered = append(filtered, value)
+		}
+	}
+	return
+}
+
+func applyLocal(local MappingLocal, directMaps [][]string, mapped *MappedProperties) (err error) {
+	if local.User != nil {
+		user := *local.User
+		for _, field := range []*string{&user.ID, &user.Name, &user.Email} {
+			if *field, err = substitute(*field, directMaps); err != nil {
+				return
+			}
+		}
+		if user.Domain, err = substituteReference(user.Domain, directMaps); err != nil {
+			return
+		}
+		mapped.User = user
+	}
+
+	if local.Group != nil {
+		group := *local.Group
+		if group.ID, err = substitute(group.ID, directMaps); err != nil {
+			return
+		}
+		if group.ID != "" {
+			mapped.GroupIDs = append(mapped.GroupIDs, group.ID)
+		} else {
+			if group.Name, err = substitute(group.Name, directMaps); err != nil {
+				return
+			}
+			if group.Domain, err = substituteReference(group.Domain, directMaps); err != nil {
+				return
+			}
+			mapped.GroupNames = append(mapped.GroupNames, group)
+		}
+	}
+
+	if local.Groups != "" {
+		if local.Domain == nil {
+			return fmt.Errorf("mapping: groups %s requires a domain", local.Groups)
+		}
+		var names []string
+		if names, err = substituteList(local.Groups, directMaps); err != nil {
+			return
+		}
+		for _, name := range names {
+			mapped.GroupNames = append(mapped.GroupNames, MappingGroup{Name: name, Domain: local.Domain})
+		}
+	}
+
+	if local.GroupIDs != "" {
+		var ids []string
+		if ids, err = substituteList(local.GroupIDs, directMaps); err != nil {
+			return
+		}
+		mapped.GroupIDs = append(mapped.GroupIDs, ids...)
+	}
+
+	if local.Projects != nil {
+		projects := make([]MappingProject, len(local.Projects))
+		for i, project := range local.Projects {
+			projects[i] = project
+			if projects[i].Name, err = substitute(project.Name, directMaps); err != nil {
+				return
+			}
+		}
+		mapped.Projects = projects
+	}
+
+	return
+}
+
+// substitute replace {N} with the Nth direct map, several values are joined with ';'
+func substitute(template string, directMaps [][]string) (result string, err error) {
+	result = mappingIndex.ReplaceAllStringFunc(template, func(match string) string {
+		values, e := directMap(match, directMaps)
+		if e != nil {
+			err = e
+			return match
+		}
+		return strings.Join(values, ";")
+	})
+	return
+}
+
+// substituteList a template which is only {N} expands to every value of the direct map
+func substituteList(template string, directMaps [][]string) ([]string, error) {
+	if mappingIndex.FindString(template) == template {
+		return directMap(template, directMaps)
+	}
+	result, err := substitute(template, directMaps)
+	return []string{result}, err
+}
+
+func substituteReference(ref *Reference, directMaps [][]string) (result *Reference, err error) {
+	if ref == nil {
+		return
+	}
+	result = &Reference{}
+	if result.ID, err = substitute(ref.ID, directMaps); err != nil {
+		return
+	}
+	result.Name, err = substitute(ref.Name, directMaps)
+	return
+}
+
+func directMap(placeholder string, directMaps [][]string) ([]string, error) {
+	index, _ := strconv.Atoi(mappingIndex.FindStringSubmatch(placeholder)[1])
+	if index >= len(directMaps) {
+		return nil, fmt.Errorf("mapping: %s is out of range, only %d remote values are mapped", placeholder, len(directMaps))
+	}
+	return directMaps[index], nil
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func unique(values []string) (result []string) {
+	for _, value := range values {
+		if !contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	return
+}
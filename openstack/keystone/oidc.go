@@ -0,0 +1,149 @@
+package keystone
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// OpenID Connect auth types, named after their keystoneauth plugins
+const (
+	OIDCPassword          = "v3oidcpassword"
+	OIDCClientCredentials = "v3oidcclientcredentials"
+	OIDCAccessToken       = "v3oidcaccesstoken"
+)
+
+const federatedAuthPath = "/OS-FEDERATION/identity_providers/$idp/protocols/$protocol/auth"
+
+// NewOIDC instance of keystone authenticating through a federated OpenID Connect identity provider
+// the unscoped federated token is rescoped to projectID, an empty projectID keeps it unscoped
+func NewOIDC(opts OIDCOptions, projectID, endpoint string) Keystone {
+	k := &keystone{Token: token{}, Endpoint: endpoint, Endpoints: make(map[string]string), oidc: &opts, mux: new(sync.Mutex)}
+	if projectID != "" {
+		k.Auth.Scope = &scope{Project: &project{ID: projectID}}
+	}
+	return newClient(k)
+}
+
+// authenticateOIDC exchange the identity provider token for an unscoped keystone token and prepare the token method for rescoping
+func (k *keystone) authenticateOIDC() (err error) {
+	accessToken, err := k.oidcAccessToken()
+	if err != nil {
+		return
+	}
+
+	path := strings.Replace(federatedAuthPath, "$idp", k.oidc.IdentityProvider, -1)
+	path = k.identityURL() + strings.Replace(path, "$protocol", k.oidc.Protocol, -1)
+
+	resp, err := k.client.NewRequest(path, "POST", nil).Header("Authorization", "Bearer "+accessToken).MetaData("keystone", "AuthenticateOIDC").DoNonAuth()
+	if err != nil {
+		return
+	}
+
+	err = k.setToken(resp)
+	if err != nil {
+		return
+	}
+
+	k.Auth.Identity = identity{Methods: []string{"token"}, Token: &tokenMethod{ID: k.Token.Value}}
+	return
+}
+
+// oidcAccessToken get a token from the identity provider token endpoint or return the configured access token
+func (k *keystone) oidcAccessToken() (accessToken string, err error) {
+	o := k.oidc
+	form := url.Values{}
+	switch o.AuthType {
+	case OIDCAccessToken:
+		return o.AccessToken, nil
+	case OIDCPassword:
+		form.Set("grant_type", "password")
+		form.Set("username", o.Username)
+		form.Set("password", o.Password)
+	case OIDCClientCredentials:
+		form.Set("grant_type", "client_credentials")
+	default:
+		return "", errors.New("unsupported oidc auth type " + o.AuthType)
+	}
+
+	scopes := o.Scope
+	if len(scopes) == 0 {
+		scopes = []string{"openid"}
+	}
+	form.Set("scope", strings.Join(scopes, " "))
+
+	tokenEndpoint, err := k.oidcTokenEndpoint()
+	if err != nil {
+		return
+	}
+
+	credentials := base64.StdEncoding.EncodeToString([]byte(o.ClientID + ":" + o.ClientSecret))
+	resp, err := k.client.NewRequest(tokenEndpoint, "POST", strings.NewReader(form.Encode())).
+		Header("Content-Type", "application/x-www-form-urlencoded").
+		Header("Authorization", "Basic "+credentials).
+		MetaData("oidc", "Token").
+		DoNonAuth()
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+
+	var tokens map[string]interface{}
+	err = json.Unmarshal(respBody, &tokens)
+	if err != nil {
+		return
+	}
+
+	tokenType := o.AccessTokenType
+	if tokenType == "" {
+		tokenType = "access_token"
+	}
+
+	accessToken, _ = tokens[tokenType].(string)
+	if accessToken == "" {
+		return "", errors.New("identity provider response has no " + tokenType)
+	}
+
+	return
+}
+
+// oidcTokenEndpoint the configured token endpoint or the one announced by the discovery document
+func (k *keystone) oidcTokenEndpoint() (tokenEndpoint string, err error) {
+	if k.oidc.TokenEndpoint != "" {
+		return k.oidc.TokenEndpoint, nil
+	}
+	if k.oidc.DiscoveryEndpoint == "" {
+		return "", errors.New("oidc requires a token or discovery endpoint")
+	}
+
+	resp, err := k.client.NewRequest(k.oidc.DiscoveryEndpoint, "GET", nil).MetaData("oidc", "Discovery").DoNonAuth()
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+	log.Debugln(string(respBody))
+
+	var discovery oidcDiscovery
+	err = json.Unmarshal(respBody, &discovery)
+	if err != nil {
+		return
+	}
+
+	return discovery.TokenEndpoint, nil
+}
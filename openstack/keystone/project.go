@@ -0,0 +1,186 @@
+package keystone
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Buni/openstack-client/openstack/client"
+)
+
+const projectsPath = "/projects"
+const projectPath = "/projects/$id"
+const projectTagsPath = "/projects/$id/tags"
+const projectTagPath = "/projects/$id/tags/$tag"
+const userProjectsPath = "/users/$id/projects"
+
+// ListProjects list projects matching opts, following the next links when keystone paginates the response
+func (k *keystone) ListProjects(opts ListProjectsOpts) (projects []Project, err error) {
+	path := k.identityURL() + projectsPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse ProjectResponse
+		err = k.do("GET", path, "ListProjects", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		projects = append(projects, jsonResponse.Projects...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// ListUserProjects list the projects the user has a role assignment on
+func (k *keystone) ListUserProjects(userID string) (projects []Project, err error) {
+	path := k.identityURL() + strings.Replace(userProjectsPath, "$id", userID, -1)
+
+	var jsonResponse ProjectResponse
+	err = k.do("GET", path, "ListUserProjects", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	projects = jsonResponse.Projects
+	return
+}
+
+// GetProject get project by id
+func (k *keystone) GetProject(projectID string) (project Project, err error) {
+	path := k.identityURL() + strings.Replace(projectPath, "$id", projectID, -1)
+
+	var jsonResponse projectResponse
+	err = k.do("GET", path, "GetProject", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	project = jsonResponse.Project
+	return
+}
+
+// CreateProject create a project, set ParentID to create it in a hierarchy
+func (k *keystone) CreateProject(opts ProjectOpts) (project Project, err error) {
+	path := k.identityURL() + projectsPath
+
+	var jsonResponse projectResponse
+	err = k.do("POST", path, "CreateProject", projectRequest{Project: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	project = jsonResponse.Project
+	return
+}
+
+// UpdateProject update only the fields set in opts
+func (k *keystone) UpdateProject(projectID string, opts ProjectOpts) (project Project, err error) {
+	path := k.identityURL() + strings.Replace(projectPath, "$id", projectID, -1)
+
+	var jsonResponse projectResponse
+	err = k.do("PATCH", path, "UpdateProject", projectRequest{Project: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	project = jsonResponse.Project
+	return
+}
+
+// DeleteProject delete project by id
+func (k *keystone) DeleteProject(projectID string) (err error) {
+	path := k.identityURL() + strings.Replace(projectPath, "$id", projectID, -1)
+	return k.do("DELETE", path, "DeleteProject", nil, nil)
+}
+
+// ListProjectTags list all project tags
+func (k *keystone) ListProjectTags(projectID string) (tags []string, err error) {
+	path := k.identityURL() + strings.Replace(projectTagsPath, "$id", projectID, -1)
+
+	var jsonResponse tagsBody
+	err = k.do("GET", path, "ListProjectTags", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	tags = jsonResponse.Tags
+	return
+}
+
+// ReplaceProjectTags replace all project tags with tags
+func (k *keystone) ReplaceProjectTags(projectID string, tags []string) (err error) {
+	path := k.identityURL() + strings.Replace(projectTagsPath, "$id", projectID, -1)
+	return k.do("PUT", path, "ReplaceProjectTags", tagsBody{Tags: tags}, nil)
+}
+
+// AddProjectTag add a single tag to the project
+func (k *keystone) AddProjectTag(projectID, tag string) (err error) {
+	return k.do("PUT", k.projectTagPath(projectID, tag), "AddProjectTag", nil, nil)
+}
+
+// CheckProjectTag check whether the project has the tag
+func (k *keystone) CheckProjectTag(projectID, tag string) (ok bool, err error) {
+	err = k.do("HEAD", k.projectTagPath(projectID, tag), "CheckProjectTag", nil, nil)
+	if client.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// DeleteProjectTag remove a single tag from the project
+func (k *keystone) DeleteProjectTag(projectID, tag string) (err error) {
+	return k.do("DELETE", k.projectTagPath(projectID, tag), "DeleteProjectTag", nil, nil)
+}
+
+// DeleteProjectTags remove all project tags
+func (k *keystone) DeleteProjectTags(projectID string) (err error) {
+	path := k.identityURL() + strings.Replace(projectTagsPath, "$id", projectID, -1)
+	return k.do("DELETE", path, "DeleteProjectTags", nil, nil)
+}
+
+func (k *keystone) projectTagPath(projectID, tag string) string {
+	path := strings.Replace(projectTagPath, "$id", projectID, -1)
+	return k.identityURL() + strings.Replace(path, "$tag", url.PathEscape(tag), -1)
+}
+
+func (o ListProjectsOpts) query() string {
+	query := url.Values{}
+	if o.DomainID != "" {
+		query.Set("domain_id", o.DomainID)
+	}
+	if o.ParentID != "" {
+		query.Set("parent_id", o.ParentID)
+	}
+	if o.Name != "" {
+		query.Set("name", o.Name)
+	}
+	if o.Enabled != nil {
+		query.Set("enabled", strconv.FormatBool(*o.Enabled))
+	}
+	if o.IsDomain != nil {
+		query.Set("is_domain", strconv.FormatBool(*o.IsDomain))
+	}
+	if len(o.Tags) > 0 {
+		query.Set("tags", strings.Join(o.Tags, ","))
+	}
+	if len(o.TagsAny) > 0 {
+		query.Set("tags-any", strings.Join(o.TagsAny, ","))
+	}
+	if len(o.NotTags) > 0 {
+		query.Set("not-tags", strings.Join(o.NotTags, ","))
+	}
+	if len(o.NotTagsAny) > 0 {
+		query.Set("not-tags-any", strings.Join(o.NotTagsAny, ","))
+	}
+	if o.Marker != "" {
+		query.Set("marker", o.Marker)
+	}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return query.Encode()
+}
@@ -0,0 +1,287 @@
+package keystone
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/Buni/openstack-client/openstack/client"
+)
+
+const rolesPath = "/roles"
+const rolePath = "/roles/$id"
+const roleAssignmentsPath = "/role_assignments"
+const roleInferencesPath = "/role_inferences"
+const impliesPath = "/roles/$id/implies"
+const impliedRolePath = "/roles/$id/implies/$implied"
+
+// ListRoles list global roles or domain specific roles when DomainID is set
+func (k *keystone) ListRoles(opts ListRolesOpts) (roles []Role, err error) {
+	path := k.identityURL() + rolesPath
+	query := url.Values{}
+	if opts.Name != "" {
+		query.Set("name", opts.Name)
+	}
+	if opts.DomainID != "" {
+		query.Set("domain_id", opts.DomainID)
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	for path != "" {
+		var jsonResponse RoleResponse
+		err = k.do("GET", path, "ListRoles", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		roles = append(roles, jsonResponse.Roles...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetRole get role by id
+func (k *keystone) GetRole(roleID string) (role Role, err error) {
+	path := k.identityURL() + strings.Replace(rolePath, "$id", roleID, -1)
+
+	var jsonResponse roleResponse
+	err = k.do("GET", path, "GetRole", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	role = jsonResponse.Role
+	return
+}
+
+// CreateRole create a global role or a domain specific role when DomainID is set
+func (k *keystone) CreateRole(opts RoleOpts) (role Role, err error) {
+	path := k.identityURL() + rolesPath
+
+	var jsonResponse roleResponse
+	err = k.do("POST", path, "CreateRole", roleRequest{Role: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	role = jsonResponse.Role
+	return
+}
+
+// UpdateRole update only the fields set in opts
+func (k *keystone) UpdateRole(roleID string, opts RoleOpts) (role Role, err error) {
+	path := k.identityURL() + strings.Replace(rolePath, "$id", roleID, -1)
+
+	var jsonResponse roleResponse
+	err = k.do("PATCH", path, "UpdateRole", roleRequest{Role: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	role = jsonResponse.Role
+	return
+}
+
+// DeleteRole delete role by id
+func (k *keystone) DeleteRole(roleID string) (err error) {
+	path := k.identityURL() + strings.Replace(rolePath, "$id", roleID, -1)
+	return k.do("DELETE", path, "DeleteRole", nil, nil)
+}
+
+// GrantRole assign the role to a user or group on a project, domain or the system
+func (k *keystone) GrantRole(grant RoleGrant) (err error) {
+	path, err := k.grantPath(grant)
+	if err != nil {
+		return
+	}
+
+	return k.do("PUT", path, "GrantRole", nil, nil)
+}
+
+// RevokeRole remove the role assignment
+func (k *keystone) RevokeRole(grant RoleGrant) (err error) {
+	path, err := k.grantPath(grant)
+	if err != nil {
+		return
+	}
+
+	return k.do("DELETE", path, "RevokeRole", nil, nil)
+}
+
+// CheckRole check whether the role is assigned, inherited and group roles are not taken into account
+func (k *keystone) CheckRole(grant RoleGrant) (ok bool, err error) {
+	path, err := k.grantPath(grant)
+	if err != nil {
+		return
+	}
+
+	err = k.do("HEAD", path, "CheckRole", nil, nil)
+	if client.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// ListGrantedRoles list the roles of a user or group on a project, domain or the system, RoleID is ignored
+func (k *keystone) ListGrantedRoles(grant RoleGrant) (roles []Role, err error) {
+	grant.RoleID = ""
+	path, err := k.grantPath(grant)
+	if err != nil {
+		return
+	}
+
+	var jsonResponse RoleResponse
+	err = k.do("GET", path, "ListGrantedRoles", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	roles = jsonResponse.Roles
+	return
+}
+
+// ListRoleAssignments query /role_assignments, use Effective to resolve group and inherited assignments
+func (k *keystone) ListRoleAssignments(opts ListRoleAssignmentsOpts) (assignments []RoleAssignment, err error) {
+	path := k.identityURL() + roleAssignmentsPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse RoleAssignmentResponse
+		err = k.do("GET", path, "ListRoleAssignments", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		assignments = append(assignments, jsonResponse.RoleAssignments...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// CreateImpliedRole make the prior role imply another role
+func (k *keystone) CreateImpliedRole(priorRoleID, impliedRoleID string) (err error) {
+	return k.do("PUT", k.impliedRolePath(priorRoleID, impliedRoleID), "CreateImpliedRole", nil, nil)
+}
+
+// CheckImpliedRole check whether the prior role implies the other role
+func (k *keystone) CheckImpliedRole(priorRoleID, impliedRoleID string) (ok bool, err error) {
+	err = k.do("HEAD", k.impliedRolePath(priorRoleID, impliedRoleID), "CheckImpliedRole", nil, nil)
+	if client.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// DeleteImpliedRole remove the inference rule
+func (k *keystone) DeleteImpliedRole(priorRoleID, impliedRoleID string) (err error) {
+	return k.do("DELETE", k.impliedRolePath(priorRoleID, impliedRoleID), "DeleteImpliedRole", nil, nil)
+}
+
+// ListImpliedRoles list the roles implied by the prior role
+func (k *keystone) ListImpliedRoles(priorRoleID string) (inference RoleInference, err error) {
+	path := k.identityURL() + strings.Replace(impliesPath, "$id", priorRoleID, -1)
+
+	var jsonResponse roleInferenceResponse
+	err = k.do("GET", path, "ListImpliedRoles", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	inference = jsonResponse.RoleInference
+	return
+}
+
+// ListRoleInferences list all inference rules
+func (k *keystone) ListRoleInferences() (inferences []RoleInference, err error) {
+	path := k.identityURL() + roleInferencesPath
+
+	var jsonResponse roleInferencesResponse
+	err = k.do("GET", path, "ListRoleInferences", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	inferences = jsonResponse.RoleInferences
+	return
+}
+
+func (k *keystone) impliedRolePath(priorRoleID, impliedRoleID string) string {
+	path := strings.Replace(impliedRolePath, "$id", priorRoleID, -1)
+	return k.identityURL() + strings.Replace(path, "$implied", impliedRoleID, -1)
+}
+
+// grantPath e.g. /projects/{project_id}/users/{user_id}/roles/{role_id}
+func (k *keystone) grantPath(grant RoleGrant) (path string, err error) {
+	switch {
+	case grant.ProjectID != "" && grant.DomainID == "" && !grant.System:
+		path = "/projects/" + grant.ProjectID
+	case grant.DomainID != "" && grant.ProjectID == "" && !grant.System:
+		path = "/domains/" + grant.DomainID
+	case grant.System && grant.ProjectID == "" && grant.DomainID == "":
+		path = "/system"
+	default:
+		return "", errors.New("exactly one of project, domain or system must be set")
+	}
+
+	switch {
+	case grant.UserID != "" && grant.GroupID == "":
+		path += "/users/" + grant.UserID
+	case grant.GroupID != "" && grant.UserID == "":
+		path += "/groups/" + grant.GroupID
+	default:
+		return "", errors.New("exactly one of user or group must be set")
+	}
+
+	path = k.identityURL() + path + rolesPath
+	if grant.RoleID != "" {
+		path += "/" + grant.RoleID
+	}
+
+	return
+}
+
+func (o ListRoleAssignmentsOpts) query() string {
+	query := url.Values{}
+	if o.UserID != "" {
+		query.Set("user.id", o.UserID)
+	}
+	if o.GroupID != "" {
+		query.Set("group.id", o.GroupID)
+	}
+	if o.RoleID != "" {
+		query.Set("role.id", o.RoleID)
+	}
+	if o.ProjectID != "" {
+		query.Set("scope.project.id", o.ProjectID)
+	}
+	if o.DomainID != "" {
+		query.Set("scope.domain.id", o.DomainID)
+	}
+	if o.System {
+		query.Set("scope.system", "all")
+	}
+
+	// keystone only checks the presence of these flags
+	flags := []string{}
+	if o.Effective {
+		flags = append(flags, "effective")
+	}
+	if o.IncludeNames {
+		flags = append(flags, "include_names")
+	}
+	if o.IncludeSubtree {
+		flags = append(flags, "include_subtree")
+	}
+
+	encoded := query.Encode()
+	if len(flags) > 0 && encoded != "" {
+		encoded += "&"
+	}
+	return encoded + strings.Join(flags, "&")
+}
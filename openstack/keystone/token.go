@@ -0,0 +1,51 @@
+package keystone
+
+import (
+	"net/url"
+
+	"github.com/Buni/openstack-client/openstack/client"
+)
+
+// ValidateToken validate subjectToken with the client token and return who it belongs to, its scope, roles and catalog
+func (k *keystone) ValidateToken(subjectToken string, opts ValidateTokenOpts) (info TokenInfo, err error) {
+	path := k.identityURL() + tokensPath
+	query := url.Values{}
+	if opts.NoCatalog {
+		query.Set("nocatalog", "true")
+	}
+	if opts.AllowExpired {
+		query.Set("allow_expired", "true")
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var jsonResponse resp
+	_, err = k.exec(k.client.NewRequest(path, "GET", nil).Header(subjectTokenHeader, subjectToken).MetaData("keystone", "ValidateToken"), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	info = jsonResponse.Token
+	return
+}
+
+// CheckToken check whether subjectToken is valid without fetching its body
+func (k *keystone) CheckToken(subjectToken string) (ok bool, err error) {
+	path := k.identityURL() + tokensPath
+
+	_, err = k.exec(k.client.NewRequest(path, "HEAD", nil).Header(subjectTokenHeader, subjectToken).MetaData("keystone", "CheckToken"), nil)
+	if client.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// RevokeToken revoke subjectToken, it can't be used afterwards
+func (k *keystone) RevokeToken(subjectToken string) (err error) {
+	path := k.identityURL() + tokensPath
+
+	_, err = k.exec(k.client.NewRequest(path, "DELETE", nil).Header(subjectTokenHeader, subjectToken).MetaData("keystone", "RevokeToken"), nil)
+	return
+}
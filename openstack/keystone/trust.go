@@ -0,0 +1,108 @@
+package keystone
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Buni/openstack-client/openstack/client"
+)
+
+const trustsPath = "/OS-TRUST/trusts"
+const trustPath = "/OS-TRUST/trusts/$id"
+const trustRolesPath = "/OS-TRUST/trusts/$id/roles"
+const trustRolePath = "/OS-TRUST/trusts/$id/roles/$role"
+
+// NewTrust instance of keystone for the trustee authenticating with a trust scope, the token acts on behalf of the trustor
+func NewTrust(name, dom, pass, trustID, endpoint string) Keystone {
+	auth := authScoped{Identity: identity{Methods: []string{"password"}, Password: &password{user{Name: name, Domain: domain{ID: dom}, Password: pass}}}, Scope: &scope{Trust: &trust{ID: trustID}}}
+	return newClient(&keystone{Auth: auth, Token: token{}, Endpoint: endpoint, Endpoints: make(map[string]string), mux: new(sync.Mutex)})
+}
+
+// ListTrusts list trusts, without filters only admins can list trusts of other users
+func (k *keystone) ListTrusts(opts ListTrustsOpts) (trusts []Trust, err error) {
+	path := k.identityURL() + trustsPath
+	query := url.Values{}
+	if opts.TrustorUserID != "" {
+		query.Set("trustor_user_id", opts.TrustorUserID)
+	}
+	if opts.TrusteeUserID != "" {
+		query.Set("trustee_user_id", opts.TrusteeUserID)
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	for path != "" {
+		var jsonResponse TrustResponse
+		err = k.do("GET", path, "ListTrusts", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		trusts = append(trusts, jsonResponse.Trusts...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetTrust get trust by id
+func (k *keystone) GetTrust(trustID string) (trust Trust, err error) {
+	path := k.identityURL() + strings.Replace(trustPath, "$id", trustID, -1)
+
+	var jsonResponse trustResponse
+	err = k.do("GET", path, "GetTrust", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	trust = jsonResponse.Trust
+	return
+}
+
+// CreateTrust create a trust, the client has to be authenticated as the trustor
+func (k *keystone) CreateTrust(opts TrustOpts) (trust Trust, err error) {
+	path := k.identityURL() + trustsPath
+
+	var jsonResponse trustResponse
+	err = k.do("POST", path, "CreateTrust", trustRequest{Trust: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	trust = jsonResponse.Trust
+	return
+}
+
+// DeleteTrust delete trust by id, tokens issued for the trust are revoked
+func (k *keystone) DeleteTrust(trustID string) (err error) {
+	path := k.identityURL() + strings.Replace(trustPath, "$id", trustID, -1)
+	return k.do("DELETE", path, "DeleteTrust", nil, nil)
+}
+
+// ListTrustRoles list the roles delegated by the trust
+func (k *keystone) ListTrustRoles(trustID string) (roles []Role, err error) {
+	path := k.identityURL() + strings.Replace(trustRolesPath, "$id", trustID, -1)
+
+	var jsonResponse RoleResponse
+	err = k.do("GET", path, "ListTrustRoles", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	roles = jsonResponse.Roles
+	return
+}
+
+// CheckTrustRole check whether the trust delegates the role
+func (k *keystone) CheckTrustRole(trustID, roleID string) (ok bool, err error) {
+	path := strings.Replace(trustRolePath, "$id", trustID, -1)
+	path = k.identityURL() + strings.Replace(path, "$role", roleID, -1)
+
+	err = k.do("HEAD", path, "CheckTrustRole", nil, nil)
+	if client.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
@@ -0,0 +1,134 @@
+package keystone
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const usersPath = "/users"
+const userPath = "/users/$id"
+const userPasswordPath = "/users/$id/password"
+const userGroupsPath = "/users/$id/groups"
+
+// ListUsers list users matching opts, following the next links when keystone paginates the response
+func (k *keystone) ListUsers(opts ListUsersOpts) (users []User, err error) {
+	path := k.identityURL() + usersPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse UserResponse
+		err = k.do("GET", path, "ListUsers", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		users = append(users, jsonResponse.Users...)
+		path = jsonResponse.Links.Next
+	}
+
+	return
+}
+
+// GetUser get user by id
+func (k *keystone) GetUser(userID string) (user User, err error) {
+	path := k.identityURL() + strings.Replace(userPath, "$id", userID, -1)
+
+	var jsonResponse userResponse
+	err = k.do("GET", path, "GetUser", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	user = jsonResponse.User
+	return
+}
+
+// CreateUser create a user, Options can be used to enable MFA rules
+func (k *keystone) CreateUser(opts UserOpts) (user User, err error) {
+	path := k.identityURL() + usersPath
+
+	var jsonResponse userResponse
+	err = k.do("POST", path, "CreateUser", userRequest{User: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	user = jsonResponse.User
+	return
+}
+
+// UpdateUser update only the fields set in opts
+func (k *keystone) UpdateUser(userID string, opts UserOpts) (user User, err error) {
+	path := k.identityURL() + strings.Replace(userPath, "$id", userID, -1)
+
+	var jsonResponse userResponse
+	err = k.do("PATCH", path, "UpdateUser", userRequest{User: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	user = jsonResponse.User
+	return
+}
+
+// DeleteUser delete user by id
+func (k *keystone) DeleteUser(userID string) (err error) {
+	path := k.identityURL() + strings.Replace(userPath, "$id", userID, -1)
+	return k.do("DELETE", path, "DeleteUser", nil, nil)
+}
+
+// ChangePassword change the user password, the original password is required by keystone
+func (k *keystone) ChangePassword(userID, originalPassword, password string) (err error) {
+	path := k.identityURL() + strings.Replace(userPasswordPath, "$id", userID, -1)
+
+	var change passwordChange
+	change.User.OriginalPassword = originalPassword
+	change.User.Password = password
+
+	return k.do("POST", path, "ChangePassword", change, nil)
+}
+
+// ListUserGroups list the groups the user is a member of
+func (k *keystone) ListUserGroups(userID string) (groups []Group, err error) {
+	path := k.identityURL() + strings.Replace(userGroupsPath, "$id", userID, -1)
+
+	var jsonResponse GroupResponse
+	err = k.do("GET", path, "ListUserGroups", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	groups = jsonResponse.Groups
+	return
+}
+
+func (o ListUsersOpts) query() string {
+	query := url.Values{}
+	if o.DomainID != "" {
+		query.Set("domain_id", o.DomainID)
+	}
+	if o.Name != "" {
+		query.Set("name", o.Name)
+	}
+	if o.Enabled != nil {
+		query.Set("enabled", strconv.FormatBool(*o.Enabled))
+	}
+	if o.IdpID != "" {
+		query.Set("idp_id", o.IdpID)
+	}
+	if o.ProtocolID != "" {
+		query.Set("protocol_id", o.ProtocolID)
+	}
+	if o.UniqueID != "" {
+		query.Set("unique_id", o.UniqueID)
+	}
+	if o.Marker != "" {
+		query.Set("marker", o.Marker)
+	}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return query.Encode()
+}
@@ -0,0 +1,518 @@
+package neutron
+
+import (
+	"encoding/json"
+)
+
+// Link resource link, Rel is next or previous
+type Link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+type links []Link
+
+// next href of the next page or empty on the last page
+func (l links) next() string {
+	for _, link := range l {
+		if link.Rel == "next" {
+			return link.Href
+		}
+	}
+	return ""
+}
+
+// NetworkResponse list networks type
+type NetworkResponse struct {
+	Networks []Network `json:"networks"`
+	Links    links     `json:"networks_links"`
+}
+
+type networkResponse struct {
+	Network Network `json:"network"`
+}
+
+// Network neutron network, the provider fields are only returned to admins
+type Network struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	Description         string   `json:"description"`
+	Status              string   `json:"status"`
+	AdminStateUp        bool     `json:"admin_state_up"`
+	Shared              bool     `json:"shared"`
+	External            bool     `json:"router:external"`
+	Subnets             []string `json:"subnets"`
+	MTU                 int      `json:"mtu"`
+	PortSecurityEnabled bool     `json:"port_security_enabled"`
+	NetworkType         string   `json:"provider:network_type"`
+	PhysicalNetwork     string   `json:"provider:physical_network"`
+	SegmentationID      int      `json:"provider:segmentation_id"`
+	AvailabilityZones   []string `json:"availability_zones"`
+	ProjectID           string   `json:"project_id"`
+	Tags                []string `json:"tags"`
+	CreatedAt           string   `json:"created_at"`
+	UpdatedAt           string   `json:"updated_at"`
+	RevisionNumber      int      `json:"revision_number"`
+}
+
+// NetworkOpts create and update network fields
+type NetworkOpts struct {
+	Name                string `json:"name,omitempty"`
+	Description         string `json:"description,omitempty"`
+	AdminStateUp        *bool  `json:"admin_state_up,omitempty"`
+	Shared              *bool  `json:"shared,omitempty"`
+	External            *bool  `json:"router:external,omitempty"`
+	MTU                 int    `json:"mtu,omitempty"`
+	PortSecurityEnabled *bool  `json:"port_security_enabled,omitempty"`
+	NetworkType         string `json:"provider:network_type,omitempty"`
+	PhysicalNetwork     string `json:"provider:physical_network,omitempty"`
+	SegmentationID      int    `json:"provider:segmentation_id,omitempty"`
+	ProjectID           string `json:"project_id,omitempty"`
+}
+
+type networkRequest struct {
+	Network NetworkOpts `json:"network"`
+}
+
+// ListNetworksOpts networks filters, Limit is the page size, all pages are always listed
+type ListNetworksOpts struct {
+	Name         string
+	Status       string
+	ProjectID    string
+	Shared       *bool
+	External     *bool
+	AdminStateUp *bool
+	Tags         []string
+	SortKey      string
+	SortDir      string
+	Limit        int
+}
+
+// SubnetResponse list subnets type
+type SubnetResponse struct {
+	Subnets []Subnet `json:"subnets"`
+	Links   links    `json:"subnets_links"`
+}
+
+type subnetResponse struct {
+	Subnet Subnet `json:"subnet"`
+}
+
+// Subnet neutron subnet, GatewayIP is empty when the subnet has no gateway
+type Subnet struct {
+	ID              string           `json:"id"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	NetworkID       string           `json:"network_id"`
+	CIDR            string           `json:"cidr"`
+	IPVersion       int              `json:"ip_version"`
+	GatewayIP       string           `json:"gateway_ip"`
+	EnableDHCP      bool             `json:"enable_dhcp"`
+	DNSNameservers  []string         `json:"dns_nameservers"`
+	AllocationPools []AllocationPool `json:"allocation_pools"`
+	HostRoutes      []HostRoute      `json:"host_routes"`
+	IPv6RAMode      string           `json:"ipv6_ra_mode"`
+	IPv6AddressMode string           `json:"ipv6_address_mode"`
+	SubnetPoolID    string           `json:"subnetpool_id"`
+	ProjectID       string           `json:"project_id"`
+	Tags            []string         `json:"tags"`
+	CreatedAt       string           `json:"created_at"`
+	UpdatedAt       string           `json:"updated_at"`
+	RevisionNumber  int              `json:"revision_number"`
+}
+
+// AllocationPool range of addresses given to ports
+type AllocationPool struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// HostRoute route pushed to the servers through dhcp
+type HostRoute struct {
+	Destination string `json:"destination"`
+	NextHop     string `json:"nexthop"`
+}
+
+// SubnetOpts create and update subnet fields, IPv6 modes are slaac, dhcpv6-stateful or dhcpv6-stateless.
+// The list pointers are only sent when set, point to an empty list to clear it, NoGateway sends a null gateway_ip
+type SubnetOpts struct {
+	NetworkID       string           `json:"network_id,omitempty"`
+	Name            string           `json:"name,omitempty"`
+	Description     string           `json:"description,omitempty"`
+	CIDR            string           `json:"cidr,omitempty"`
+	IPVersion       int              `json:"ip_version,omitempty"`
+	GatewayIP       string           `json:"gateway_ip,omitempty"`
+	NoGateway       bool             `json:"-"`
+	EnableDHCP      *bool            `json:"enable_dhcp,omitempty"`
+	DNSNameservers  *[]string        `json:"dns_nameservers,omitempty"`
+	AllocationPools []AllocationPool `json:"allocation_pools,omitempty"`
+	HostRoutes      *[]HostRoute     `json:"host_routes,omitempty"`
+	IPv6RAMode      string           `json:"ipv6_ra_mode,omitempty"`
+	IPv6AddressMode string           `json:"ipv6_address_mode,omitempty"`
+	SubnetPoolID    string           `json:"subnetpool_id,omitempty"`
+	PrefixLen       int              `json:"prefixlen,omitempty"`
+	ProjectID       string           `json:"project_id,omitempty"`
+}
+
+// MarshalJSON gateway_ip is null with NoGateway so a subnet without a gateway can be created
+func (o SubnetOpts) MarshalJSON() ([]byte, error) {
+	type subnetOpts SubnetOpts
+	if !o.NoGateway {
+		return json.Marshal(subnetOpts(o))
+	}
+	return json.Marshal(struct {
+		subnetOpts
+		GatewayIP *string `json:"gateway_ip"`
+	}{subnetOpts: subnetOpts(o)})
+}
+
+type subnetRequest struct {
+	Subnet SubnetOpts `json:"subnet"`
+}
+
+// ListSubnetsOpts subnets filters, Limit is the page size, all pages are always listed
+type ListSubnetsOpts struct {
+	Name       string
+	NetworkID  string
+	CIDR       string
+	IPVersion  int
+	ProjectID  string
+	EnableDHCP *bool
+	SortKey    string
+	SortDir    string
+	Limit      int
+}
+
+// PortResponse list ports type
+type PortResponse struct {
+	Ports []Port `json:"ports"`
+	Links links  `json:"ports_links"`
+}
+
+type portResponse struct {
+	Port Port `json:"port"`
+}
+
+// Port neutron port, the binding fields are only returned to admins
+type Port struct {
+	ID                  string                 `json:"id"`
+	Name                string                 `json:"name"`
+	Description         string                 `json:"description"`
+	NetworkID           string                 `json:"network_id"`
+	MACAddress          string                 `json:"mac_address"`
+	FixedIPs            []FixedIP              `json:"fixed_ips"`
+	DeviceID            string                 `json:"device_id"`
+	DeviceOwner         string                 `json:"device_owner"`
+	Status              string                 `json:"status"`
+	AdminStateUp        bool                   `json:"admin_state_up"`
+	SecurityGroups      []string               `json:"security_groups"`
+	AllowedAddressPairs []AddressPair          `json:"allowed_address_pairs"`
+	PortSecurityEnabled bool                   `json:"port_security_enabled"`
+	DNSName             string                 `json:"dns_name"`
+	BindingHostID       string                 `json:"binding:host_id"`
+	BindingVNICType     string                 `json:"binding:vnic_type"`
+	BindingVIFType      string                 `json:"binding:vif_type"`
+	BindingVIFDetails   map[string]interface{} `json:"binding:vif_details"`
+	BindingProfile      map[string]interface{} `json:"binding:profile"`
+	ProjectID           string                 `json:"project_id"`
+	Tags                []string               `json:"tags"`
+	CreatedAt           string                 `json:"created_at"`
+	UpdatedAt           string                 `json:"updated_at"`
+	RevisionNumber      int                    `json:"revision_number"`
+}
+
+// FixedIP address of a port, set only SubnetID to get any free address of the subnet
+type FixedIP struct {
+	SubnetID  string `json:"subnet_id,omitempty"`
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+// AddressPair extra address or cidr allowed to leave the port e.g. a vrrp virtual ip
+type AddressPair struct {
+	IPAddress  string `json:"ip_address"`
+	MACAddress string `json:"mac_address,omitempty"`
+}
+
+// PortOpts create and update port fields, BindingVNICType is normal, direct, macvtap or baremetal.
+// SecurityGroups and AllowedAddressPairs are only sent when set, point to an empty list to clear them
+type PortOpts struct {
+	NetworkID           string                 `json:"network_id,omitempty"`
+	Name                string                 `json:"name,omitempty"`
+	Description         string                 `json:"description,omitempty"`
+	AdminStateUp        *bool                  `json:"admin_state_up,omitempty"`
+	MACAddress          string                 `json:"mac_address,omitempty"`
+	FixedIPs            []FixedIP              `json:"fixed_ips,omitempty"`
+	DeviceID            string                 `json:"device_id,omitempty"`
+	DeviceOwner         string                 `json:"device_owner,omitempty"`
+	SecurityGroups      *[]string              `json:"security_groups,omitempty"`
+	AllowedAddressPairs *[]AddressPair         `json:"allowed_address_pairs,omitempty"`
+	PortSecurityEnabled *bool                  `json:"port_security_enabled,omitempty"`
+	DNSName             string                 `json:"dns_name,omitempty"`
+	BindingHostID       string                 `json:"binding:host_id,omitempty"`
+	BindingVNICType     string                 `json:"binding:vnic_type,omitempty"`
+	BindingProfile      map[string]interface{} `json:"binding:profile,omitempty"`
+	ProjectID           string                 `json:"project_id,omitempty"`
+}
+
+type portRequest struct {
+	Port PortOpts `json:"port"`
+}
+
+// ListPortsOpts ports filters, IPAddress and SubnetID filter on the fixed ips
+type ListPortsOpts struct {
+	Name        string
+	NetworkID   string
+	DeviceID    string
+	DeviceOwner string
+	MACAddress  string
+	IPAddress   string
+	SubnetID    string
+	Status      string
+	ProjectID   string
+	Tags        []string
+	SortKey     string
+	SortDir     string
+	Limit       int
+}
+
+// SecurityGroupResponse list security groups type
+type SecurityGroupResponse struct {
+	SecurityGroups []SecurityGroup `json:"security_groups"`
+	Links          links           `json:"security_groups_links"`
+}
+
+type securityGroupResponse struct {
+	SecurityGroup SecurityGroup `json:"security_group"`
+}
+
+// SecurityGroup neutron security group
+type SecurityGroup struct {
+	ID             string              `json:"id"`
+	Name           string              `json:"name"`
+	Description    string              `json:"description"`
+	Stateful       bool                `json:"stateful"`
+	Rules          []SecurityGroupRule `json:"security_group_rules"`
+	ProjectID      string              `json:"project_id"`
+	Tags           []string            `json:"tags"`
+	CreatedAt      string              `json:"created_at"`
+	UpdatedAt      string              `json:"updated_at"`
+	RevisionNumber int                 `json:"revision_number"`
+}
+
+// SecurityGroupOpts create and update security group fields
+type SecurityGroupOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Stateful    *bool  `json:"stateful,omitempty"`
+	ProjectID   string `json:"project_id,omitempty"`
+}
+
+type securityGroupRequest struct {
+	SecurityGroup SecurityGroupOpts `json:"security_group"`
+}
+
+// ListSecurityGroupsOpts security groups filters, Limit is the page size, all pages are always listed
+type ListSecurityGroupsOpts struct {
+	Name      string
+	ProjectID string
+	Tags      []string
+	SortKey   string
+	SortDir   string
+	Limit     int
+}
+
+// SecurityGroupRuleResponse list security group rules type
+type SecurityGroupRuleResponse struct {
+	SecurityGroupRules []SecurityGroupRule `json:"security_group_rules"`
+	Links              links               `json:"security_group_rules_links"`
+}
+
+type securityGroupRuleResponse struct {
+	SecurityGroupRule SecurityGroupRule `json:"security_group_rule"`
+}
+
+// SecurityGroupRule Direction is ingress or egress, nil port ranges and an empty Protocol match everything,
+// for icmp the port range holds the icmp type and code
+type SecurityGroupRule struct {
+	ID              string `json:"id"`
+	SecurityGroupID string `json:"security_group_id"`
+	Direction       string `json:"direction"`
+	EtherType       string `json:"ethertype"`
+	Protocol        string `json:"protocol"`
+	PortRangeMin    *int   `json:"port_range_min"`
+	PortRangeMax    *int   `json:"port_range_max"`
+	RemoteIPPrefix  string `json:"remote_ip_prefix"`
+	RemoteGroupID   string `json:"remote_group_id"`
+	Description     string `json:"description"`
+	ProjectID       string `json:"project_id"`
+}
+
+// SecurityGroupRuleOpts create security group rule fields, EtherType defaults to IPv4
+type SecurityGroupRuleOpts struct {
+	SecurityGroupID string `json:"security_group_id"`
+	Direction       string `json:"direction"`
+	EtherType       string `json:"ethertype,omitempty"`
+	Protocol        string `json:"protocol,omitempty"`
+	PortRangeMin    *int   `json:"port_range_min,omitempty"`
+	PortRangeMax    *int   `json:"port_range_max,omitempty"`
+	RemoteIPPrefix  string `json:"remote_ip_prefix,omitempty"`
+	RemoteGroupID   string `json:"remote_group_id,omitempty"`
+	Description     string `json:"description,omitempty"`
+}
+
+type securityGroupRuleRequest struct {
+	SecurityGroupRule SecurityGroupRuleOpts `json:"security_group_rule"`
+}
+
+// RouterResponse list routers type
+type RouterResponse struct {
+	Routers []Router `json:"routers"`
+	Links   links    `json:"routers_links"`
+}
+
+type routerResponse struct {
+	Router Router `json:"router"`
+}
+
+// Router neutron router, Routes are the extra static routes
+type Router struct {
+	ID                  string       `json:"id"`
+	Name                string       `json:"name"`
+	Description         string       `json:"description"`
+	Status              string       `json:"status"`
+	AdminStateUp        bool         `json:"admin_state_up"`
+	ExternalGatewayInfo *GatewayInfo `json:"external_gateway_info"`
+	Routes              []HostRoute  `json:"routes"`
+	Distributed         bool         `json:"distributed"`
+	HA                  bool         `json:"ha"`
+	AvailabilityZones   []string     `json:"availability_zones"`
+	ProjectID           string       `json:"project_id"`
+	Tags                []string     `json:"tags"`
+	CreatedAt           string       `json:"created_at"`
+	UpdatedAt           string       `json:"updated_at"`
+	RevisionNumber      int          `json:"revision_number"`
+}
+
+// GatewayInfo external network of a router, ExternalFixedIPs and EnableSNAT are admin only by default
+type GatewayInfo struct {
+	NetworkID        string    `json:"network_id,omitempty"`
+	EnableSNAT       *bool     `json:"enable_snat,omitempty"`
+	ExternalFixedIPs []FixedIP `json:"external_fixed_ips,omitempty"`
+}
+
+// RouterOpts create and update router fields
+type RouterOpts struct {
+	Name                string       `json:"name,omitempty"`
+	Description         string       `json:"description,omitempty"`
+	AdminStateUp        *bool        `json:"admin_state_up,omitempty"`
+	ExternalGatewayInfo *GatewayInfo `json:"external_gateway_info,omitempty"`
+	Distributed         *bool        `json:"distributed,omitempty"`
+	HA                  *bool        `json:"ha,omitempty"`
+	ProjectID           string       `json:"project_id,omitempty"`
+}
+
+type routerRequest struct {
+	Router RouterOpts `json:"router"`
+}
+
+// ListRoutersOpts routers filters, Limit is the page size, all pages are always listed
+type ListRoutersOpts struct {
+	Name      string
+	Status    string
+	ProjectID string
+	Tags      []string
+	SortKey   string
+	SortDir   string
+	Limit     int
+}
+
+// RouterInterfaceOpts set either SubnetID or PortID
+type RouterInterfaceOpts struct {
+	SubnetID string `json:"subnet_id,omitempty"`
+	PortID   string `json:"port_id,omitempty"`
+}
+
+// RouterInterface port connecting a router to a subnet
+type RouterInterface struct {
+	ID        string   `json:"id"`
+	SubnetID  string   `json:"subnet_id"`
+	SubnetIDs []string `json:"subnet_ids"`
+	PortID    string   `json:"port_id"`
+	ProjectID string   `json:"project_id"`
+}
+
+type extraRoutes struct {
+	Routes []HostRoute `json:"routes"`
+}
+
+type extraRoutesRequest struct {
+	Router extraRoutes `json:"router"`
+}
+
+// FloatingIPResponse list floating ips type
+type FloatingIPResponse struct {
+	FloatingIPs []FloatingIP `json:"floatingips"`
+	Links       links        `json:"floatingips_links"`
+}
+
+type floatingIPResponse struct {
+	FloatingIP FloatingIP `json:"floatingip"`
+}
+
+// FloatingIP public address, PortID is empty while it is not associated
+type FloatingIP struct {
+	ID                string   `json:"id"`
+	FloatingIPAddress string   `json:"floating_ip_address"`
+	FloatingNetworkID string   `json:"floating_network_id"`
+	RouterID          string   `json:"router_id"`
+	PortID            string   `json:"port_id"`
+	FixedIPAddress    string   `json:"fixed_ip_address"`
+	Status            string   `json:"status"`
+	Description       string   `json:"description"`
+	DNSName           string   `json:"dns_name"`
+	DNSDomain         string   `json:"dns_domain"`
+	ProjectID         string   `json:"project_id"`
+	Tags              []string `json:"tags"`
+	CreatedAt         string   `json:"created_at"`
+	UpdatedAt         string   `json:"updated_at"`
+	RevisionNumber    int      `json:"revision_number"`
+}
+
+// FloatingIPOpts allocate floating ip fields, FloatingIPAddress and SubnetID request a specific address or subnet
+type FloatingIPOpts struct {
+	FloatingNetworkID string `json:"floating_network_id"`
+	FloatingIPAddress string `json:"floating_ip_address,omitempty"`
+	SubnetID          string `json:"subnet_id,omitempty"`
+	PortID            string `json:"port_id,omitempty"`
+	FixedIPAddress    string `json:"fixed_ip_address,omitempty"`
+	Description       string `json:"description,omitempty"`
+	ProjectID         string `json:"project_id,omitempty"`
+}
+
+type floatingIPRequest struct {
+	FloatingIP FloatingIPOpts `json:"floatingip"`
+}
+
+// floatingIPUpdate a nil PortID disassociates the floating ip
+type floatingIPUpdate struct {
+	PortID         *string `json:"port_id"`
+	FixedIPAddress string  `json:"fixed_ip_address,omitempty"`
+}
+
+type floatingIPUpdateRequest struct {
+	FloatingIP floatingIPUpdate `json:"floatingip"`
+}
+
+// ListFloatingIPsOpts floating ips filters, Limit is the page size, all pages are always listed
+type ListFloatingIPsOpts struct {
+	FloatingIPAddress string
+	FloatingNetworkID string
+	PortID            string
+	FixedIPAddress    string
+	RouterID          string
+	Status            string
+	ProjectID         string
+	SortKey           string
+	SortDir           string
+	Limit             int
+}
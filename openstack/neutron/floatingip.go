@@ -0,0 +1,257 @@
+package neutron
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+const floatingIPsPath = "/floatingips"
+const floatingIPPath = "/floatingips/$id"
+
+// ListFloatingIPs list floating ips following the next links of every page
+func (n *neutron) ListFloatingIPs(opts ListFloatingIPsOpts) (floatingIPs []FloatingIP, err error) {
+	path := n.endpoint() + floatingIPsPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse FloatingIPResponse
+		err = n.do("GET", path, "ListFloatingIPs", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		floatingIPs = append(floatingIPs, jsonResponse.FloatingIPs...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetFloatingIP get floating ip by id
+func (n *neutron) GetFloatingIP(floatingIPID string) (floatingIP FloatingIP, err error) {
+	path := n.endpoint() + strings.Replace(floatingIPPath, "$id", floatingIPID, -1)
+
+	var jsonResponse floatingIPResponse
+	err = n.do("GET", path, "GetFloatingIP", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	floatingIP = jsonResponse.FloatingIP
+	return
+}
+
+// AllocateFloatingIP allocate a floating ip from an external network, it is associated right away when opts.PortID is set
+func (n *neutron) AllocateFloatingIP(opts FloatingIPOpts) (floatingIP FloatingIP, err error) {
+	path := n.endpoint() + floatingIPsPath
+
+	var jsonResponse floatingIPResponse
+	err = n.do("POST", path, "AllocateFloatingIP", floatingIPRequest{FloatingIP: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	floatingIP = jsonResponse.FloatingIP
+	return
+}
+
+// AssociateFloatingIP associate the floating ip to the port, fixedIP selects the address when the port has several
+func (n *neutron) AssociateFloatingIP(floatingIPID, portID, fixedIP string) (floatingIP FloatingIP, err error) {
+	return n.updateFloatingIP(floatingIPID, "AssociateFloatingIP", floatingIPUpdate{PortID: &portID, FixedIPAddress: fixedIP})
+}
+
+// DisassociateFloatingIP detach the floating ip from its port, it stays allocated to the project
+func (n *neutron) DisassociateFloatingIP(floatingIPID string) (floatingIP FloatingIP, err error) {
+	return n.updateFloatingIP(floatingIPID, "DisassociateFloatingIP", floatingIPUpdate{})
+}
+
+// ReleaseFloatingIP give the floating ip back to the external network
+func (n *neutron) ReleaseFloatingIP(floatingIPID string) (err error) {
+	path := n.endpoint() + strings.Replace(floatingIPPath, "$id", floatingIPID, -1)
+	return n.do("DELETE", path, "ReleaseFloatingIP", nil, nil)
+}
+
+func (n *neutron) updateFloatingIP(floatingIPID, operation string, update floatingIPUpdate) (floatingIP FloatingIP, err error) {
+	path := n.endpoint() + strings.Replace(floatingIPPath, "$id", floatingIPID, -1)
+
+	var jsonResponse floatingIPResponse
+	err = n.do("PUT", path, operation, floatingIPUpdateRequest{FloatingIP: update}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	floatingIP = jsonResponse.FloatingIP
+	return
+}
+
+// FindServerPort the first port of the server with an IPv4 address which is not on an external network,
+// networkID is optional
+func (n *neutron) FindServerPort(serverID, networkID string) (port Port, err error) {
+	externals, err := n.externalNetworks()
+	if err != nil {
+		return
+	}
+	ports, err := n.ListPorts(ListPortsOpts{DeviceID: serverID, NetworkID: networkID})
+	if err != nil {
+		return
+	}
+
+	internal := internalPorts(ports, externals)
+	if len(internal) == 0 {
+		err = fmt.Errorf("neutron: server %s has no port with an IPv4 address on an internal network", serverID)
+		return
+	}
+
+	port = internal[0]
+	return
+}
+
+// AssignPublicIP give the server a floating ip from externalNetworkID, the only external network is used when it is
+// empty. The port used is the first internal port of the server on a subnet attached to a router with a gateway on
+// the external network, a floating ip already associated to that port is returned as is.
+func (n *neutron) AssignPublicIP(serverID, externalNetworkID string) (floatingIP FloatingIP, err error) {
+	externals, err := n.externalNetworks()
+	if err != nil {
+		return
+	}
+	if externalNetworkID == "" {
+		if len(externals) != 1 {
+			err = fmt.Errorf("neutron: %d external networks found, the external network is required", len(externals))
+			return
+		}
+		externalNetworkID = externals[0].ID
+	}
+
+	ports, err := n.ListPorts(ListPortsOpts{DeviceID: serverID})
+	if err != nil {
+		return
+	}
+	internal := internalPorts(ports, externals)
+	if len(internal) == 0 {
+		err = fmt.Errorf("neutron: server %s has no port with an IPv4 address on an internal network", serverID)
+		return
+	}
+
+	routed, err := n.routedSubnets(externalNetworkID)
+	if err != nil {
+		return
+	}
+	var port Port
+	var fixedIP string
+	for _, p := range internal {
+		if fixedIP = routedIPv4(p, routed); fixedIP != "" {
+			port = p
+			break
+		}
+	}
+	if fixedIP == "" {
+		err = fmt.Errorf("neutron: port %s of server %s is not on a subnet with a router to external network %s", internal[0].ID, serverID, externalNetworkID)
+		return
+	}
+
+	associated, err := n.ListFloatingIPs(ListFloatingIPsOpts{PortID: port.ID})
+	if err != nil {
+		return
+	}
+	if len(associated) > 0 {
+		floatingIP = associated[0]
+		return
+	}
+
+	return n.AllocateFloatingIP(FloatingIPOpts{FloatingNetworkID: externalNetworkID, PortID: port.ID, FixedIPAddress: fixedIP})
+}
+
+func (n *neutron) externalNetworks() (networks []Network, err error) {
+	external := true
+	return n.ListNetworks(ListNetworksOpts{External: &external})
+}
+
+// routedSubnets the subnets attached to a router whose gateway is on externalNetworkID
+func (n *neutron) routedSubnets(externalNetworkID string) (subnets map[string]bool, err error) {
+	routers, err := n.ListRouters(ListRoutersOpts{})
+	if err != nil {
+		return
+	}
+
+	subnets = make(map[string]bool)
+	for _, router := range routers {
+		if router.ExternalGatewayInfo == nil || router.ExternalGatewayInfo.NetworkID != externalNetworkID {
+			continue
+		}
+		var ports []Port
+		ports, err = n.ListPorts(ListPortsOpts{DeviceID: router.ID})
+		if err != nil {
+			return
+		}
+		for _, port := range ports {
+			for _, fixedIP := range port.FixedIPs {
+				subnets[fixedIP.SubnetID] = true
+			}
+		}
+	}
+	return
+}
+
+// internalPorts the ports with an IPv4 address which are not on one of the external networks
+func internalPorts(ports []Port, externals []Network) (internal []Port) {
+	for _, port := range ports {
+		external := false
+		for _, network := range externals {
+			external = external || network.ID == port.NetworkID
+		}
+		if !external && ipv4(port) != "" {
+			internal = append(internal, port)
+		}
+	}
+	return
+}
+
+// routedIPv4 first IPv4 fixed address of the port on one of the routed subnets
+func routedIPv4(port Port, routed map[string]bool) string {
+	for _, fixedIP := range port.FixedIPs {
+		if ip := net.ParseIP(fixedIP.IPAddress); ip != nil && ip.To4() != nil && routed[fixedIP.SubnetID] {
+			return fixedIP.IPAddress
+		}
+	}
+	return ""
+}
+
+// ipv4 first IPv4 fixed address of the port, floating ips are IPv4 only
+func ipv4(port Port) string {
+	for _, fixedIP := range port.FixedIPs {
+		if ip := net.ParseIP(fixedIP.IPAddress); ip != nil && ip.To4() != nil {
+			return fixedIP.IPAddress
+		}
+	}
+	return ""
+}
+
+func (o ListFloatingIPsOpts) query() string {
+	query := url.Values{}
+	if o.FloatingIPAddress != "" {
+		query.Set("floating_ip_address", o.FloatingIPAddress)
+	}
+	if o.FloatingNetworkID != "" {
+		query.Set("floating_network_id", o.FloatingNetworkID)
+	}
+	if o.PortID != "" {
+		query.Set("port_id", o.PortID)
+	}
+	if o.FixedIPAddress != "" {
+		query.Set("fixed_ip_address", o.FixedIPAddress)
+	}
+	if o.RouterID != "" {
+		query.Set("router_id", o.RouterID)
+	}
+	if o.Status != "" {
+		query.Set("status", o.Status)
+	}
+	if o.ProjectID != "" {
+		query.Set("project_id", o.ProjectID)
+	}
+	setPage(query, o.SortKey, o.SortDir, o.Limit)
+	return query.Encode()
+}
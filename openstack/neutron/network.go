@@ -0,0 +1,118 @@
+package neutron
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const networksPath = "/networks"
+const networkPath = "/networks/$id"
+
+// ListNetworks list networks following the next links of every page
+func (n *neutron) ListNetworks(opts ListNetworksOpts) (networks []Network, err error) {
+	path := n.endpoint() + networksPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse NetworkResponse
+		err = n.do("GET", path, "ListNetworks", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		networks = append(networks, jsonResponse.Networks...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetNetwork get network by id
+func (n *neutron) GetNetwork(networkID string) (network Network, err error) {
+	path := n.endpoint() + strings.Replace(networkPath, "$id", networkID, -1)
+
+	var jsonResponse networkResponse
+	err = n.do("GET", path, "GetNetwork", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	network = jsonResponse.Network
+	return
+}
+
+// CreateNetwork create a network, provider fields are admin only
+func (n *neutron) CreateNetwork(opts NetworkOpts) (network Network, err error) {
+	path := n.endpoint() + networksPath
+
+	var jsonResponse networkResponse
+	err = n.do("POST", path, "CreateNetwork", networkRequest{Network: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	network = jsonResponse.Network
+	return
+}
+
+// UpdateNetwork update only the fields set in opts
+func (n *neutron) UpdateNetwork(networkID string, opts NetworkOpts) (network Network, err error) {
+	path := n.endpoint() + strings.Replace(networkPath, "$id", networkID, -1)
+
+	var jsonResponse networkResponse
+	err = n.do("PUT", path, "UpdateNetwork", networkRequest{Network: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	network = jsonResponse.Network
+	return
+}
+
+// DeleteNetwork delete network by id together with its subnets, it fails while ports are in use
+func (n *neutron) DeleteNetwork(networkID string) (err error) {
+	path := n.endpoint() + strings.Replace(networkPath, "$id", networkID, -1)
+	return n.do("DELETE", path, "DeleteNetwork", nil, nil)
+}
+
+func (o ListNetworksOpts) query() string {
+	query := url.Values{}
+	if o.Name != "" {
+		query.Set("name", o.Name)
+	}
+	if o.Status != "" {
+		query.Set("status", o.Status)
+	}
+	if o.ProjectID != "" {
+		query.Set("project_id", o.ProjectID)
+	}
+	if o.Shared != nil {
+		query.Set("shared", strconv.FormatBool(*o.Shared))
+	}
+	if o.External != nil {
+		query.Set("router:external", strconv.FormatBool(*o.External))
+	}
+	if o.AdminStateUp != nil {
+		query.Set("admin_state_up", strconv.FormatBool(*o.AdminStateUp))
+	}
+	if len(o.Tags) > 0 {
+		query.Set("tags", strings.Join(o.Tags, ","))
+	}
+	setPage(query, o.SortKey, o.SortDir, o.Limit)
+	return query.Encode()
+}
+
+// setPage set the sorting and page size shared by all list requests
+func setPage(query url.Values, sortKey, sortDir string, limit int) {
+	if sortKey != "" {
+		query.Set("sort_key", sortKey)
+	}
+	if sortDir != "" {
+		query.Set("sort_dir", sortDir)
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+}
@@ -0,0 +1,118 @@
+package neutron
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/Buni/openstack-client/openstack/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// Neutron Client
+type neutron struct {
+	Client client.Client
+}
+
+// Neutron interface
+type Neutron interface {
+	ListNetworks(opts ListNetworksOpts) (networks []Network, err error)
+	GetNetwork(networkID string) (network Network, err error)
+	CreateNetwork(opts NetworkOpts) (network Network, err error)
+	UpdateNetwork(networkID string, opts NetworkOpts) (network Network, err error)
+	DeleteNetwork(networkID string) (err error)
+
+	ListSubnets(opts ListSubnetsOpts) (subnets []Subnet, err error)
+	GetSubnet(subnetID string) (subnet Subnet, err error)
+	CreateSubnet(opts SubnetOpts) (subnet Subnet, err error)
+	UpdateSubnet(subnetID string, opts SubnetOpts) (subnet Subnet, err error)
+	DeleteSubnet(subnetID string) (err error)
+
+	ListPorts(opts ListPortsOpts) (ports []Port, err error)
+	GetPort(portID string) (port Port, err error)
+	CreatePort(opts PortOpts) (port Port, err error)
+	UpdatePort(portID string, opts PortOpts) (port Port, err error)
+	DeletePort(portID string) (err error)
+
+	ListSecurityGroups(opts ListSecurityGroupsOpts) (groups []SecurityGroup, err error)
+	GetSecurityGroup(groupID string) (group SecurityGroup, err error)
+	CreateSecurityGroup(opts SecurityGroupOpts) (group SecurityGroup, err error)
+	UpdateSecurityGroup(groupID string, opts SecurityGroupOpts) (group SecurityGroup, err error)
+	DeleteSecurityGroup(groupID string) (err error)
+	ListSecurityGroupRules(groupID string) (rules []SecurityGroupRule, err error)
+	GetSecurityGroupRule(ruleID string) (rule SecurityGroupRule, err error)
+	CreateSecurityGroupRule(opts SecurityGroupRuleOpts) (rule SecurityGroupRule, err error)
+	DeleteSecurityGroupRule(ruleID string) (err error)
+	SyncSecurityGroupRules(groupID string, desired []SecurityGroupRuleOpts) (created, deleted []SecurityGroupRule, err error)
+
+	ListRouters(opts ListRoutersOpts) (routers []Router, err error)
+	GetRouter(routerID string) (router Router, err error)
+	CreateRouter(opts RouterOpts) (router Router, err error)
+	UpdateRouter(routerID string, opts RouterOpts) (router Router, err error)
+	DeleteRouter(routerID string) (err error)
+	AddRouterInterface(routerID string, opts RouterInterfaceOpts) (routerInterface RouterInterface, err error)
+	RemoveRouterInterface(routerID string, opts RouterInterfaceOpts) (routerInterface RouterInterface, err error)
+	AddExtraRoutes(routerID string, routes []HostRoute) (router Router, err error)
+	RemoveExtraRoutes(routerID string, routes []HostRoute) (router Router, err error)
+
+	ListFloatingIPs(opts ListFloatingIPsOpts) (floatingIPs []FloatingIP, err error)
+	GetFloatingIP(floatingIPID string) (floatingIP FloatingIP, err error)
+	AllocateFloatingIP(opts FloatingIPOpts) (floatingIP FloatingIP, err error)
+	AssociateFloatingIP(floatingIPID, portID, fixedIP string) (floatingIP FloatingIP, err error)
+	DisassociateFloatingIP(floatingIPID string) (floatingIP FloatingIP, err error)
+	ReleaseFloatingIP(floatingIPID string) (err error)
+	FindServerPort(serverID, networkID string) (port Port, err error)
+	AssignPublicIP(serverID, externalNetworkID string) (floatingIP FloatingIP, err error)
+}
+
+const apiVersion = "/v2.0"
+
+// New Neutron
+func New(authClient client.Client) Neutron {
+	return &neutron{Client: authClient}
+}
+
+func (n *neutron) endpoint() string {
+	return strings.TrimSuffix(n.Client.GetEndpoint("neutron"), "/") + apiVersion
+}
+
+// do marshal in as the request body, execute the request and unmarshal the response body into out
+func (n *neutron) do(method, path, operation string, in, out interface{}) (err error) {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewBuffer(payload)
+	}
+
+	_, err = n.exec(n.Client.NewRequest(path, method, body).MetaData("neutron", operation), out)
+	return
+}
+
+// exec execute an already prepared request, the returned response body is already closed
+func (n *neutron) exec(req *client.Request, out interface{}) (resp *http.Response, err error) {
+	resp, err = req.Context(context.TODO()).Do()
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+	log.Debugln(string(respBody))
+
+	if out == nil || len(respBody) == 0 {
+		return
+	}
+
+	err = json.Unmarshal(respBody, out)
+	return
+}
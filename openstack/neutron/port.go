@@ -0,0 +1,113 @@
+package neutron
+
+import (
+	"net/url"
+	"strings"
+)
+
+const portsPath = "/ports"
+const portPath = "/ports/$id"
+
+// ListPorts list ports following the next links of every page
+func (n *neutron) ListPorts(opts ListPortsOpts) (ports []Port, err error) {
+	path := n.endpoint() + portsPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse PortResponse
+		err = n.do("GET", path, "ListPorts", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		ports = append(ports, jsonResponse.Ports...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetPort get port by id
+func (n *neutron) GetPort(portID string) (port Port, err error) {
+	path := n.endpoint() + strings.Replace(portPath, "$id", portID, -1)
+
+	var jsonResponse portResponse
+	err = n.do("GET", path, "GetPort", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	port = jsonResponse.Port
+	return
+}
+
+// CreatePort create a port, an address of every subnet of the network is allocated when FixedIPs is empty
+func (n *neutron) CreatePort(opts PortOpts) (port Port, err error) {
+	path := n.endpoint() + portsPath
+
+	var jsonResponse portResponse
+	err = n.do("POST", path, "CreatePort", portRequest{Port: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	port = jsonResponse.Port
+	return
+}
+
+// UpdatePort update only the fields set in opts, FixedIPs, SecurityGroups and AllowedAddressPairs replace the current lists
+func (n *neutron) UpdatePort(portID string, opts PortOpts) (port Port, err error) {
+	path := n.endpoint() + strings.Replace(portPath, "$id", portID, -1)
+
+	var jsonResponse portResponse
+	err = n.do("PUT", path, "UpdatePort", portRequest{Port: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	port = jsonResponse.Port
+	return
+}
+
+// DeletePort delete port by id
+func (n *neutron) DeletePort(portID string) (err error) {
+	path := n.endpoint() + strings.Replace(portPath, "$id", portID, -1)
+	return n.do("DELETE", path, "DeletePort", nil, nil)
+}
+
+func (o ListPortsOpts) query() string {
+	query := url.Values{}
+	if o.Name != "" {
+		query.Set("name", o.Name)
+	}
+	if o.NetworkID != "" {
+		query.Set("network_id", o.NetworkID)
+	}
+	if o.DeviceID != "" {
+		query.Set("device_id", o.DeviceID)
+	}
+	if o.DeviceOwner != "" {
+		query.Set("device_owner", o.DeviceOwner)
+	}
+	if o.MACAddress != "" {
+		query.Set("mac_address", o.MACAddress)
+	}
+	if o.IPAddress != "" {
+		query.Add("fixed_ips", "ip_address="+o.IPAddress)
+	}
+	if o.SubnetID != "" {
+		query.Add("fixed_ips", "subnet_id="+o.SubnetID)
+	}
+	if o.Status != "" {
+		query.Set("status", o.Status)
+	}
+	if o.ProjectID != "" {
+		query.Set("project_id", o.ProjectID)
+	}
+	if len(o.Tags) > 0 {
+		query.Set("tags", strings.Join(o.Tags, ","))
+	}
+	setPage(query, o.SortKey, o.SortDir, o.Limit)
+	return query.Encode()
+}
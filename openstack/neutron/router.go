@@ -0,0 +1,137 @@
+package neutron
+
+import (
+	"net/url"
+	"strings"
+)
+
+const routersPath = "/routers"
+const routerPath = "/routers/$id"
+const routerActionPath = "/routers/$id/$action"
+
+// ListRouters list routers following the next links of every page
+func (n *neutron) ListRouters(opts ListRoutersOpts) (routers []Router, err error) {
+	path := n.endpoint() + routersPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse RouterResponse
+		err = n.do("GET", path, "ListRouters", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		routers = append(routers, jsonResponse.Routers...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetRouter get router by id
+func (n *neutron) GetRouter(routerID string) (router Router, err error) {
+	path := n.endpoint() + strings.Replace(routerPath, "$id", routerID, -1)
+
+	var jsonResponse routerResponse
+	err = n.do("GET", path, "GetRouter", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	router = jsonResponse.Router
+	return
+}
+
+// CreateRouter create a router, set ExternalGatewayInfo to route to an external network
+func (n *neutron) CreateRouter(opts RouterOpts) (router Router, err error) {
+	path := n.endpoint() + routersPath
+
+	var jsonResponse routerResponse
+	err = n.do("POST", path, "CreateRouter", routerRequest{Router: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	router = jsonResponse.Router
+	return
+}
+
+// UpdateRouter update only the fields set in opts, an empty ExternalGatewayInfo removes the gateway
+func (n *neutron) UpdateRouter(routerID string, opts RouterOpts) (router Router, err error) {
+	path := n.endpoint() + strings.Replace(routerPath, "$id", routerID, -1)
+
+	var jsonResponse routerResponse
+	err = n.do("PUT", path, "UpdateRouter", routerRequest{Router: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	router = jsonResponse.Router
+	return
+}
+
+// DeleteRouter delete router by id, its interfaces have to be removed first
+func (n *neutron) DeleteRouter(routerID string) (err error) {
+	path := n.endpoint() + strings.Replace(routerPath, "$id", routerID, -1)
+	return n.do("DELETE", path, "DeleteRouter", nil, nil)
+}
+
+// AddRouterInterface connect the router to a subnet, through the subnet gateway ip or through an existing port
+func (n *neutron) AddRouterInterface(routerID string, opts RouterInterfaceOpts) (routerInterface RouterInterface, err error) {
+	err = n.do("PUT", n.routerActionPath(routerID, "add_router_interface"), "AddRouterInterface", opts, &routerInterface)
+	return
+}
+
+// RemoveRouterInterface disconnect the router from a subnet or port
+func (n *neutron) RemoveRouterInterface(routerID string, opts RouterInterfaceOpts) (routerInterface RouterInterface, err error) {
+	err = n.do("PUT", n.routerActionPath(routerID, "remove_router_interface"), "RemoveRouterInterface", opts, &routerInterface)
+	return
+}
+
+// AddExtraRoutes add static routes to the router keeping the existing ones
+func (n *neutron) AddExtraRoutes(routerID string, routes []HostRoute) (router Router, err error) {
+	var jsonResponse routerResponse
+	err = n.do("PUT", n.routerActionPath(routerID, "add_extraroutes"), "AddExtraRoutes", extraRoutesRequest{Router: extraRoutes{Routes: routes}}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	router = jsonResponse.Router
+	return
+}
+
+// RemoveExtraRoutes remove static routes from the router keeping the other ones
+func (n *neutron) RemoveExtraRoutes(routerID string, routes []HostRoute) (router Router, err error) {
+	var jsonResponse routerResponse
+	err = n.do("PUT", n.routerActionPath(routerID, "remove_extraroutes"), "RemoveExtraRoutes", extraRoutesRequest{Router: extraRoutes{Routes: routes}}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	router = jsonResponse.Router
+	return
+}
+
+func (n *neutron) routerActionPath(routerID, action string) string {
+	path := strings.Replace(routerActionPath, "$id", routerID, -1)
+	return n.endpoint() + strings.Replace(path, "$action", action, -1)
+}
+
+func (o ListRoutersOpts) query() string {
+	query := url.Values{}
+	if o.Name != "" {
+		query.Set("name", o.Name)
+	}
+	if o.Status != "" {
+		query.Set("status", o.Status)
+	}
+	if o.ProjectID != "" {
+		query.Set("project_id", o.ProjectID)
+	}
+	if len(o.Tags) > 0 {
+		query.Set("tags", strings.Join(o.Tags, ","))
+	}
+	setPage(query, o.SortKey, o.SortDir, o.Limit)
+	return query.Encode()
+}
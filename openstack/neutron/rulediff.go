@@ -0,0 +1,73 @@
+package neutron
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// protocolNames neutron accepts protocol numbers as well as names
+var protocolNames = map[string]string{"1": "icmp", "6": "tcp", "17": "udp", "58": "ipv6-icmp", "icmpv6": "ipv6-icmp", "any": ""}
+
+// DiffSecurityGroupRules compare the actual rules of a group with the desired ones, descriptions are ignored
+// and equivalent rules e.g. protocol 6 and tcp or remote 0.0.0.0/0 and no remote are considered equal
+func DiffSecurityGroupRules(actual []SecurityGroupRule, desired []SecurityGroupRuleOpts) (create []SecurityGroupRuleOpts, remove []SecurityGroupRule) {
+	wanted := map[string]bool{}
+	for _, opts := range desired {
+		wanted[opts.key()] = true
+	}
+
+	existing := map[string]bool{}
+	for _, rule := range actual {
+		key := rule.key()
+		if !wanted[key] || existing[key] {
+			remove = append(remove, rule)
+			continue
+		}
+		existing[key] = true
+	}
+
+	for _, opts := range desired {
+		key := opts.key()
+		if !existing[key] {
+			existing[key] = true
+			create = append(create, opts)
+		}
+	}
+	return
+}
+
+func (r SecurityGroupRule) key() string {
+	return ruleKey(r.Direction, r.EtherType, r.Protocol, r.PortRangeMin, r.PortRangeMax, r.RemoteIPPrefix, r.RemoteGroupID)
+}
+
+func (o SecurityGroupRuleOpts) key() string {
+	return ruleKey(o.Direction, o.EtherType, o.Protocol, o.PortRangeMin, o.PortRangeMax, o.RemoteIPPrefix, o.RemoteGroupID)
+}
+
+func ruleKey(direction, etherType, protocol string, portMin, portMax *int, remoteIPPrefix, remoteGroupID string) string {
+	if etherType == "" {
+		etherType = "IPv4"
+	}
+
+	protocol = strings.ToLower(protocol)
+	if name, ok := protocolNames[protocol]; ok {
+		protocol = name
+	}
+
+	if _, network, err := net.ParseCIDR(remoteIPPrefix); err == nil {
+		remoteIPPrefix = network.String()
+		if ones, _ := network.Mask.Size(); ones == 0 {
+			remoteIPPrefix = ""
+		}
+	}
+
+	return strings.Join([]string{direction, etherType, protocol, port(portMin), port(portMax), remoteIPPrefix, remoteGroupID}, "|")
+}
+
+func port(p *int) string {
+	if p == nil {
+		return ""
+	}
+	return strconv.Itoa(*p)
+}
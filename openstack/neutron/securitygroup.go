@@ -0,0 +1,178 @@
+package neutron
+
+import (
+	"net/url"
+	"strings"
+)
+
+const securityGroupsPath = "/security-groups"
+const securityGroupPath = "/security-groups/$id"
+const securityGroupRulesPath = "/security-group-rules"
+const securityGroupRulePath = "/security-group-rules/$id"
+
+// ListSecurityGroups list security groups with their rules following the next links of every page
+func (n *neutron) ListSecurityGroups(opts ListSecurityGroupsOpts) (groups []SecurityGroup, err error) {
+	path := n.endpoint() + securityGroupsPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse SecurityGroupResponse
+		err = n.do("GET", path, "ListSecurityGroups", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		groups = append(groups, jsonResponse.SecurityGroups...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetSecurityGroup get security group by id
+func (n *neutron) GetSecurityGroup(groupID string) (group SecurityGroup, err error) {
+	path := n.endpoint() + strings.Replace(securityGroupPath, "$id", groupID, -1)
+
+	var jsonResponse securityGroupResponse
+	err = n.do("GET", path, "GetSecurityGroup", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	group = jsonResponse.SecurityGroup
+	return
+}
+
+// CreateSecurityGroup create a security group, neutron adds egress rules allowing all traffic
+func (n *neutron) CreateSecurityGroup(opts SecurityGroupOpts) (group SecurityGroup, err error) {
+	path := n.endpoint() + securityGroupsPath
+
+	var jsonResponse securityGroupResponse
+	err = n.do("POST", path, "CreateSecurityGroup", securityGroupRequest{SecurityGroup: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	group = jsonResponse.SecurityGroup
+	return
+}
+
+// UpdateSecurityGroup update only the fields set in opts
+func (n *neutron) UpdateSecurityGroup(groupID string, opts SecurityGroupOpts) (group SecurityGroup, err error) {
+	path := n.endpoint() + strings.Replace(securityGroupPath, "$id", groupID, -1)
+
+	var jsonResponse securityGroupResponse
+	err = n.do("PUT", path, "UpdateSecurityGroup", securityGroupRequest{SecurityGroup: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	group = jsonResponse.SecurityGroup
+	return
+}
+
+// DeleteSecurityGroup delete security group by id, it fails while ports use it
+func (n *neutron) DeleteSecurityGroup(groupID string) (err error) {
+	path := n.endpoint() + strings.Replace(securityGroupPath, "$id", groupID, -1)
+	return n.do("DELETE", path, "DeleteSecurityGroup", nil, nil)
+}
+
+// ListSecurityGroupRules list the rules of a security group or of all groups when groupID is empty
+func (n *neutron) ListSecurityGroupRules(groupID string) (rules []SecurityGroupRule, err error) {
+	path := n.endpoint() + securityGroupRulesPath
+	if groupID != "" {
+		path += "?" + url.Values{"security_group_id": {groupID}}.Encode()
+	}
+
+	for path != "" {
+		var jsonResponse SecurityGroupRuleResponse
+		err = n.do("GET", path, "ListSecurityGroupRules", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		rules = append(rules, jsonResponse.SecurityGroupRules...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetSecurityGroupRule get security group rule by id
+func (n *neutron) GetSecurityGroupRule(ruleID string) (rule SecurityGroupRule, err error) {
+	path := n.endpoint() + strings.Replace(securityGroupRulePath, "$id", ruleID, -1)
+
+	var jsonResponse securityGroupRuleResponse
+	err = n.do("GET", path, "GetSecurityGroupRule", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	rule = jsonResponse.SecurityGroupRule
+	return
+}
+
+// CreateSecurityGroupRule add a rule to opts.SecurityGroupID, rules can't be updated only replaced
+func (n *neutron) CreateSecurityGroupRule(opts SecurityGroupRuleOpts) (rule SecurityGroupRule, err error) {
+	path := n.endpoint() + securityGroupRulesPath
+
+	var jsonResponse securityGroupRuleResponse
+	err = n.do("POST", path, "CreateSecurityGroupRule", securityGroupRuleRequest{SecurityGroupRule: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	rule = jsonResponse.SecurityGroupRule
+	return
+}
+
+// DeleteSecurityGroupRule delete security group rule by id
+func (n *neutron) DeleteSecurityGroupRule(ruleID string) (err error) {
+	path := n.endpoint() + strings.Replace(securityGroupRulePath, "$id", ruleID, -1)
+	return n.do("DELETE", path, "DeleteSecurityGroupRule", nil, nil)
+}
+
+// SyncSecurityGroupRules make the rules of the group match desired, missing rules are created before
+// the rules which are not desired are deleted so allowed traffic is never interrupted
+func (n *neutron) SyncSecurityGroupRules(groupID string, desired []SecurityGroupRuleOpts) (created, deleted []SecurityGroupRule, err error) {
+	actual, err := n.ListSecurityGroupRules(groupID)
+	if err != nil {
+		return
+	}
+
+	create, remove := DiffSecurityGroupRules(actual, desired)
+	for _, opts := range create {
+		opts.SecurityGroupID = groupID
+		var rule SecurityGroupRule
+		rule, err = n.CreateSecurityGroupRule(opts)
+		if err != nil {
+			return
+		}
+		created = append(created, rule)
+	}
+
+	for _, rule := range remove {
+		err = n.DeleteSecurityGroupRule(rule.ID)
+		if err != nil {
+			return
+		}
+		deleted = append(deleted, rule)
+	}
+
+	return
+}
+
+func (o ListSecurityGroupsOpts) query() string {
+	query := url.Values{}
+	if o.Name != "" {
+		query.Set("name", o.Name)
+	}
+	if o.ProjectID != "" {
+		query.Set("project_id", o.ProjectID)
+	}
+	if len(o.Tags) > 0 {
+		query.Set("tags", strings.Join(o.Tags, ","))
+	}
+	setPage(query, o.SortKey, o.SortDir, o.Limit)
+	return query.Encode()
+}
@@ -0,0 +1,102 @@
+package neutron
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const subnetsPath = "/subnets"
+const subnetPath = "/subnets/$id"
+
+// ListSubnets list subnets following the next links of every page
+func (n *neutron) ListSubnets(opts ListSubnetsOpts) (subnets []Subnet, err error) {
+	path := n.endpoint() + subnetsPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse SubnetResponse
+		err = n.do("GET", path, "ListSubnets", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		subnets = append(subnets, jsonResponse.Subnets...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetSubnet get subnet by id
+func (n *neutron) GetSubnet(subnetID string) (subnet Subnet, err error) {
+	path := n.endpoint() + strings.Replace(subnetPath, "$id", subnetID, -1)
+
+	var jsonResponse subnetResponse
+	err = n.do("GET", path, "GetSubnet", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	subnet = jsonResponse.Subnet
+	return
+}
+
+// CreateSubnet create a subnet from opts.CIDR or allocate one from opts.SubnetPoolID
+func (n *neutron) CreateSubnet(opts SubnetOpts) (subnet Subnet, err error) {
+	path := n.endpoint() + subnetsPath
+
+	var jsonResponse subnetResponse
+	err = n.do("POST", path, "CreateSubnet", subnetRequest{Subnet: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	subnet = jsonResponse.Subnet
+	return
+}
+
+// UpdateSubnet update only the fields set in opts, the network, cidr and ip version can't be changed
+func (n *neutron) UpdateSubnet(subnetID string, opts SubnetOpts) (subnet Subnet, err error) {
+	path := n.endpoint() + strings.Replace(subnetPath, "$id", subnetID, -1)
+
+	var jsonResponse subnetResponse
+	err = n.do("PUT", path, "UpdateSubnet", subnetRequest{Subnet: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	subnet = jsonResponse.Subnet
+	return
+}
+
+// DeleteSubnet delete subnet by id, it fails while ports use an address of the subnet
+func (n *neutron) DeleteSubnet(subnetID string) (err error) {
+	path := n.endpoint() + strings.Replace(subnetPath, "$id", subnetID, -1)
+	return n.do("DELETE", path, "DeleteSubnet", nil, nil)
+}
+
+func (o ListSubnetsOpts) query() string {
+	query := url.Values{}
+	if o.Name != "" {
+		query.Set("name", o.Name)
+	}
+	if o.NetworkID != "" {
+		query.Set("network_id", o.NetworkID)
+	}
+	if o.CIDR != "" {
+		query.Set("cidr", o.CIDR)
+	}
+	if o.IPVersion > 0 {
+		query.Set("ip_version", strconv.Itoa(o.IPVersion))
+	}
+	if o.ProjectID != "" {
+		query.Set("project_id", o.ProjectID)
+	}
+	if o.EnableDHCP != nil {
+		query.Set("enable_dhcp", strconv.FormatBool(*o.EnableDHCP))
+	}
+	setPage(query, o.SortKey, o.SortDir, o.Limit)
+	return query.Encode()
+}
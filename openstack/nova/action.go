@@ -0,0 +1,233 @@
+package nova
+
+import (
+	"net/http"
+	"path"
+	"strings"
+)
+
+const serverActionPath = "/servers/$id/action"
+const instanceActionsPath = "/servers/$id/os-instance-actions"
+const instanceActionPath = "/servers/$id/os-instance-actions/$request"
+
+const liveMigrateMicroversion = "compute 2.25"  // block_migration auto was added in 2.25
+const migrateHostMicroversion = "compute 2.56"  // cold migrate to a host was added in 2.56
+const lockedReasonMicroversion = "compute 2.73" // lock reason was added in 2.73
+
+// RebootType soft reboot signals the guest, hard reboot power cycles it
+type RebootType string
+
+const (
+	// SoftReboot graceful reboot through the guest
+	SoftReboot RebootType = "SOFT"
+	// HardReboot power cycle the server
+	HardReboot RebootType = "HARD"
+)
+
+// StartServer power on a SHUTOFF server
+func (n *nova) StartServer(serverID string) (err error) {
+	_, err = n.action(serverID, "StartServer", "os-start", nil, "", nil)
+	return
+}
+
+// StopServer power off an ACTIVE server
+func (n *nova) StopServer(serverID string) (err error) {
+	_, err = n.action(serverID, "StopServer", "os-stop", nil, "", nil)
+	return
+}
+
+// RebootServer reboot the server, rebootType is SoftReboot or HardReboot
+func (n *nova) RebootServer(serverID string, rebootType RebootType) (err error) {
+	_, err = n.action(serverID, "RebootServer", "reboot", rebootRequest{Type: rebootType}, "", nil)
+	return
+}
+
+// PauseServer keep the server in memory without running it
+func (n *nova) PauseServer(serverID string) (err error) {
+	_, err = n.action(serverID, "PauseServer", "pause", nil, "", nil)
+	return
+}
+
+// UnpauseServer run a PAUSED server again
+func (n *nova) UnpauseServer(serverID string) (err error) {
+	_, err = n.action(serverID, "UnpauseServer", "unpause", nil, "", nil)
+	return
+}
+
+// SuspendServer store the server memory on disk
+func (n *nova) SuspendServer(serverID string) (err error) {
+	_, err = n.action(serverID, "SuspendServer", "suspend", nil, "", nil)
+	return
+}
+
+// ResumeServer resume a SUSPENDED server
+func (n *nova) ResumeServer(serverID string) (err error) {
+	_, err = n.action(serverID, "ResumeServer", "resume", nil, "", nil)
+	return
+}
+
+// ShelveServer shut down the server and release its hypervisor resources
+func (n *nova) ShelveServer(serverID string) (err error) {
+	_, err = n.action(serverID, "ShelveServer", "shelve", nil, "", nil)
+	return
+}
+
+// UnshelveServer boot a SHELVED or SHELVED_OFFLOADED server again
+func (n *nova) UnshelveServer(serverID string) (err error) {
+	_, err = n.action(serverID, "UnshelveServer", "unshelve", nil, "", nil)
+	return
+}
+
+// ResizeServer move the server to a new flavor, it goes to VERIFY_RESIZE until confirmed or reverted
+func (n *nova) ResizeServer(serverID string, opts ResizeOpts) (err error) {
+	_, err = n.action(serverID, "ResizeServer", "resize", opts, "", nil)
+	return
+}
+
+// ConfirmResize confirm a resize and release the resources of the old flavor
+func (n *nova) ConfirmResize(serverID string) (err error) {
+	_, err = n.action(serverID, "ConfirmResize", "confirmResize", nil, "", nil)
+	return
+}
+
+// RevertResize go back to the old flavor
+func (n *nova) RevertResize(serverID string) (err error) {
+	_, err = n.action(serverID, "RevertResize", "revertResize", nil, "", nil)
+	return
+}
+
+// RebuildServer reinstall the server from an image keeping its id and addresses
+func (n *nova) RebuildServer(serverID string, opts RebuildOpts) (server Server, err error) {
+	var microversion string
+	if opts.Description != "" {
+		microversion = descriptionMicroversion
+	}
+
+	var jsonResponse serverResponse
+	_, err = n.action(serverID, "RebuildServer", "rebuild", opts, microversion, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	server = jsonResponse.Server
+	return
+}
+
+// RescueServer boot the server from a rescue image, the returned password is the rescue admin password
+func (n *nova) RescueServer(serverID string, opts RescueOpts) (adminPass string, err error) {
+	var jsonResponse rescueResponse
+	_, err = n.action(serverID, "RescueServer", "rescue", opts, "", &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	adminPass = jsonResponse.AdminPass
+	return
+}
+
+// UnrescueServer boot a RESCUE server from its own disk again
+func (n *nova) UnrescueServer(serverID string) (err error) {
+	_, err = n.action(serverID, "UnrescueServer", "unrescue", nil, "", nil)
+	return
+}
+
+// LockServer prevent non admin users from acting on the server, reason is optional
+func (n *nova) LockServer(serverID, reason string) (err error) {
+	if reason == "" {
+		_, err = n.action(serverID, "LockServer", "lock", nil, "", nil)
+		return
+	}
+	_, err = n.action(serverID, "LockServer", "lock", lockRequest{LockedReason: reason}, lockedReasonMicroversion, nil)
+	return
+}
+
+// UnlockServer unlock a locked server
+func (n *nova) UnlockServer(serverID string) (err error) {
+	_, err = n.action(serverID, "UnlockServer", "unlock", nil, "", nil)
+	return
+}
+
+// CreateServerImage snapshot the server into a new image and return the image id, no microversion is sent so
+// older clouds keep working, the id is read from the body or else from the Location header
+func (n *nova) CreateServerImage(serverID string, opts CreateImageOpts) (imageID string, err error) {
+	var jsonResponse createImageResponse
+	resp, err := n.action(serverID, "CreateServerImage", "createImage", opts, "", &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	imageID = jsonResponse.ImageID
+	if imageID == "" {
+		// the image id is only returned in the body from 2.45 on
+		imageID = path.Base(resp.Header.Get("Location"))
+	}
+	return
+}
+
+// LiveMigrateServer move a running server to another host, the scheduler picks the host when opts.Host is empty
+func (n *nova) LiveMigrateServer(serverID string, opts LiveMigrateOpts) (err error) {
+	request := liveMigrateRequest{BlockMigration: "auto"}
+	if opts.Host != "" {
+		request.Host = &opts.Host
+	}
+	if opts.BlockMigration != nil {
+		request.BlockMigration = *opts.BlockMigration
+	}
+
+	_, err = n.action(serverID, "LiveMigrateServer", "os-migrateLive", request, liveMigrateMicroversion, nil)
+	return
+}
+
+// MigrateServer cold migrate the server, it goes to VERIFY_RESIZE like a resize
+func (n *nova) MigrateServer(serverID string, opts MigrateOpts) (err error) {
+	if opts.Host == "" {
+		_, err = n.action(serverID, "MigrateServer", "migrate", nil, "", nil)
+		return
+	}
+	_, err = n.action(serverID, "MigrateServer", "migrate", opts, migrateHostMicroversion, nil)
+	return
+}
+
+// ListInstanceActions list the actions performed on the server, newest first
+func (n *nova) ListInstanceActions(serverID string) (actions []InstanceAction, err error) {
+	path := n.endpoint() + strings.Replace(instanceActionsPath, "$id", serverID, -1)
+
+	var jsonResponse instanceActionsResponse
+	err = n.do("GET", path, "ListInstanceActions", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	actions = jsonResponse.InstanceActions
+	return
+}
+
+// GetInstanceAction get a server action including its events by request id
+func (n *nova) GetInstanceAction(serverID, requestID string) (action InstanceAction, err error) {
+	path := strings.Replace(instanceActionPath, "$id", serverID, -1)
+	path = n.endpoint() + strings.Replace(path, "$request", requestID, -1)
+
+	var jsonResponse instanceActionResponse
+	err = n.do("GET", path, "GetInstanceAction", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	action = jsonResponse.InstanceAction
+	return
+}
+
+// action post {name: body} to the server action endpoint, a nil body is sent as null
+func (n *nova) action(serverID, operation, name string, body interface{}, microversion string, out interface{}) (resp *http.Response, err error) {
+	path := n.endpoint() + strings.Replace(serverActionPath, "$id", serverID, -1)
+
+	req, err := n.request("POST", path, operation, map[string]interface{}{name: body})
+	if err != nil {
+		return
+	}
+	if microversion != "" {
+		req.Header(apiVersionHeader, microversion)
+	}
+
+	return n.exec(req, out)
+}
@@ -0,0 +1,113 @@
+package nova
+
+import (
+	"strconv"
+	"strings"
+)
+
+const aggregatesPath = "/os-aggregates"
+const aggregatePath = "/os-aggregates/$id"
+const aggregateActionPath = "/os-aggregates/$id/action"
+
+const aggregateMicroversion = "compute 2.41" // aggregate uuid was added in 2.41
+
+// ListAggregates list all host aggregates
+func (n *nova) ListAggregates() (aggregates []Aggregate, err error) {
+	path := n.endpoint() + aggregatesPath
+
+	req, err := n.request("GET", path, "ListAggregates", nil)
+	if err != nil {
+		return
+	}
+
+	var jsonResponse aggregatesResponse
+	_, err = n.exec(req.Header(apiVersionHeader, aggregateMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	aggregates = jsonResponse.Aggregates
+	return
+}
+
+// GetAggregate get aggregate by id
+func (n *nova) GetAggregate(aggregateID int) (aggregate Aggregate, err error) {
+	return n.aggregate("GET", aggregatePath, aggregateID, "GetAggregate", nil)
+}
+
+// CreateAggregate create a host aggregate, setting AvailabilityZone exposes it as an availability zone
+func (n *nova) CreateAggregate(opts AggregateOpts) (aggregate Aggregate, err error) {
+	path := n.endpoint() + aggregatesPath
+
+	req, err := n.request("POST", path, "CreateAggregate", aggregateRequest{Aggregate: opts})
+	if err != nil {
+		return
+	}
+
+	var jsonResponse aggregateResponse
+	_, err = n.exec(req.Header(apiVersionHeader, aggregateMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	aggregate = jsonResponse.Aggregate
+	return
+}
+
+// UpdateAggregate rename the aggregate or change its availability zone
+func (n *nova) UpdateAggregate(aggregateID int, opts AggregateOpts) (aggregate Aggregate, err error) {
+	return n.aggregate("PUT", aggregatePath, aggregateID, "UpdateAggregate", aggregateRequest{Aggregate: opts})
+}
+
+// DeleteAggregate delete an aggregate without hosts
+func (n *nova) DeleteAggregate(aggregateID int) (err error) {
+	path := n.endpoint() + strings.Replace(aggregatePath, "$id", strconv.Itoa(aggregateID), -1)
+	return n.do("DELETE", path, "DeleteAggregate", nil, nil)
+}
+
+// AddAggregateHost add a compute host to the aggregate
+func (n *nova) AddAggregateHost(aggregateID int, host string) (aggregate Aggregate, err error) {
+	return n.aggregate("POST", aggregateActionPath, aggregateID, "AddAggregateHost", map[string]aggregateHostRequest{"add_host": {Host: host}})
+}
+
+// RemoveAggregateHost remove a compute host from the aggregate
+func (n *nova) RemoveAggregateHost(aggregateID int, host string) (aggregate Aggregate, err error) {
+	return n.aggregate("POST", aggregateActionPath, aggregateID, "RemoveAggregateHost", map[string]aggregateHostRequest{"remove_host": {Host: host}})
+}
+
+// SetAggregateMetadata create or overwrite the given metadata keys, other keys are kept
+func (n *nova) SetAggregateMetadata(aggregateID int, metadata map[string]string) (aggregate Aggregate, err error) {
+	values := make(map[string]*string, len(metadata))
+	for key := range metadata {
+		value := metadata[key]
+		values[key] = &value
+	}
+	return n.aggregate("POST", aggregateActionPath, aggregateID, "SetAggregateMetadata", map[string]aggregateMetadataRequest{"set_metadata": {Metadata: values}})
+}
+
+// DeleteAggregateMetadata remove the given metadata keys
+func (n *nova) DeleteAggregateMetadata(aggregateID int, keys ...string) (aggregate Aggregate, err error) {
+	values := make(map[string]*string, len(keys))
+	for _, key := range keys {
+		values[key] = nil
+	}
+	return n.aggregate("POST", aggregateActionPath, aggregateID, "DeleteAggregateMetadata", map[string]aggregateMetadataRequest{"set_metadata": {Metadata: values}})
+}
+
+func (n *nova) aggregate(method, path string, aggregateID int, operation string, in interface{}) (aggregate Aggregate, err error) {
+	path = n.endpoint() + strings.Replace(path, "$id", strconv.Itoa(aggregateID), -1)
+
+	req, err := n.request(method, path, operation, in)
+	if err != nil {
+		return
+	}
+
+	var jsonResponse aggregateResponse
+	_, err = n.exec(req.Header(apiVersionHeader, aggregateMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	aggregate = jsonResponse.Aggregate
+	return
+}
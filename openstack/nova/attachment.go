@@ -0,0 +1,93 @@
+package nova
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Buni/openstack-client/openstack/cinder"
+)
+
+const volumeAttachmentsPath = "/servers/$id/os-volume_attachments"
+const volumeAttachmentPath = "/servers/$id/os-volume_attachments/$volume"
+
+// AttachVolume attach the volume to the server, the returned Device is the one requested from the hypervisor
+func (n *nova) AttachVolume(serverID string, opts AttachVolumeOpts) (attachment VolumeAttachment, err error) {
+	path := n.endpoint() + strings.Replace(volumeAttachmentsPath, "$id", serverID, -1)
+
+	var jsonResponse volumeAttachmentResponse
+	err = n.do("POST", path, "AttachVolume", volumeAttachmentRequest{VolumeAttachment: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	attachment = jsonResponse.VolumeAttachment
+	return
+}
+
+// DetachVolume detach the volume from the server, the volume is available again once detached by cinder
+func (n *nova) DetachVolume(serverID, volumeID string) (err error) {
+	path := strings.Replace(volumeAttachmentPath, "$id", serverID, -1)
+	path = n.endpoint() + strings.Replace(path, "$volume", volumeID, -1)
+	return n.do("DELETE", path, "DetachVolume", nil, nil)
+}
+
+// ListAttachments list the volumes attached to the server with their device
+func (n *nova) ListAttachments(serverID string) (attachments []VolumeAttachment, err error) {
+	path := n.endpoint() + strings.Replace(volumeAttachmentsPath, "$id", serverID, -1)
+
+	var jsonResponse volumeAttachmentsResponse
+	err = n.do("GET", path, "ListAttachments", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	attachments = jsonResponse.VolumeAttachments
+	return
+}
+
+// CreateAndAttachVolume create a cinder volume, attach it to the server and wait until the volume is in-use,
+// on failure the volume is detached and deleted again and cleanup errors are wrapped with the original error
+func (n *nova) CreateAndAttachVolume(serverID string, volumes cinder.Cinder, opts cinder.VolumeOpts, timeout time.Duration) (attachment VolumeAttachment, err error) {
+	deadline := time.Now().Add(timeout)
+
+	volume, err := volumes.CreateVolume(opts)
+	if err != nil {
+		return
+	}
+	volumeID := volume.Volume.ID
+
+	attached := false
+	_, err = volumes.WaitForVolumeStatus(volumeID, "available", time.Until(deadline))
+	if err == nil {
+		attachment, err = n.AttachVolume(serverID, AttachVolumeOpts{VolumeID: volumeID})
+		attached = err == nil
+	}
+	if err == nil {
+		_, err = volumes.WaitForVolumeStatus(volumeID, "in-use", time.Until(deadline))
+	}
+	if err != nil {
+		if cleanupErr := n.cleanupVolume(serverID, volumeID, volumes, attached, timeout); cleanupErr != nil {
+			err = fmt.Errorf("nova: %w, cleaning up volume %s failed: %v", err, volumeID, cleanupErr)
+		}
+	}
+	return
+}
+
+// cleanupVolume detach the volume when it was attached and delete it once cinder no longer uses it
+func (n *nova) cleanupVolume(serverID, volumeID string, volumes cinder.Cinder, attached bool, timeout time.Duration) (err error) {
+	if attached {
+		err = n.DetachVolume(serverID, volumeID)
+		if err != nil {
+			return
+		}
+	}
+
+	// a volume still creating or detaching can not be deleted yet, errored volumes can
+	volume, err := volumes.WaitForVolumeStatus(volumeID, "available", timeout)
+	if err != nil && !strings.HasPrefix(volume.Volume.Status, "error") {
+		return
+	}
+
+	return volumes.DeleteVolume(volumeID)
+}
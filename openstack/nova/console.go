@@ -0,0 +1,45 @@
+package nova
+
+import (
+	"strings"
+)
+
+const remoteConsolesPath = "/servers/$id/remote-consoles"
+
+const remoteConsoleMicroversion = "compute 2.8" // remote consoles were added in 2.6 and webmks in 2.8
+
+// GetConsoleOutput get the last lines of the server console log, lines <= 0 returns the whole log
+func (n *nova) GetConsoleOutput(serverID string, lines int) (output string, err error) {
+	request := consoleOutputRequest{}
+	if lines > 0 {
+		request.Length = &lines
+	}
+
+	var jsonResponse consoleOutputResponse
+	_, err = n.action(serverID, "GetConsoleOutput", "os-getConsoleOutput", request, "", &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	output = jsonResponse.Output
+	return
+}
+
+// CreateRemoteConsole get a console url, protocol is vnc, spice, serial, rdp or mks with a matching console type e.g. novnc
+func (n *nova) CreateRemoteConsole(serverID string, opts RemoteConsoleOpts) (console RemoteConsole, err error) {
+	path := n.endpoint() + strings.Replace(remoteConsolesPath, "$id", serverID, -1)
+
+	req, err := n.request("POST", path, "CreateRemoteConsole", remoteConsoleRequest{RemoteConsole: opts})
+	if err != nil {
+		return
+	}
+
+	var jsonResponse remoteConsoleResponse
+	_, err = n.exec(req.Header(apiVersionHeader, remoteConsoleMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	console = jsonResponse.RemoteConsole
+	return
+}
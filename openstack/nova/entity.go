@@ -0,0 +1,814 @@
+package nova
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Link resource link, Rel is self, bookmark or next
+type Link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+type links []Link
+
+// next href of the next page or empty on the last page
+func (l links) next() string {
+	for _, link := range l {
+		if link.Rel == "next" {
+			return link.Href
+		}
+	}
+	return ""
+}
+
+// Reference id and links of a related resource e.g. the server image or flavor
+type Reference struct {
+	ID    string `json:"id"`
+	Links []Link `json:"links,omitempty"`
+}
+
+// UnmarshalJSON servers booted from volume return an empty string instead of the image
+func (r *Reference) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		*r = Reference{}
+		return nil
+	}
+	type reference Reference
+	return json.Unmarshal(data, (*reference)(r))
+}
+
+// ServerResponse list servers type
+type ServerResponse struct {
+	Servers []Server `json:"servers"`
+	Links   links    `json:"servers_links"`
+}
+
+type serverResponse struct {
+	Server Server `json:"server"`
+}
+
+// Server nova server, admin only fields are empty for regular users
+type Server struct {
+	ID                 string               `json:"id"`
+	Name               string               `json:"name"`
+	Status             string               `json:"status"`
+	TenantID           string               `json:"tenant_id"`
+	UserID             string               `json:"user_id"`
+	HostID             string               `json:"hostId"`
+	Image              Reference            `json:"image"`
+	Flavor             Reference            `json:"flavor"`
+	Addresses          map[string][]Address `json:"addresses"`
+	Metadata           map[string]string    `json:"metadata"`
+	KeyName            string               `json:"key_name"`
+	SecurityGroups     []SecurityGroup      `json:"security_groups"`
+	AccessIPv4         string               `json:"accessIPv4"`
+	AccessIPv6         string               `json:"accessIPv6"`
+	AdminPass          string               `json:"adminPass"`
+	Progress           int                  `json:"progress"`
+	Description        string               `json:"description"`
+	Created            string               `json:"created"`
+	Updated            string               `json:"updated"`
+	AvailabilityZone   string               `json:"OS-EXT-AZ:availability_zone"`
+	Host               string               `json:"OS-EXT-SRV-ATTR:host"`
+	HypervisorHostname string               `json:"OS-EXT-SRV-ATTR:hypervisor_hostname"`
+	InstanceName       string               `json:"OS-EXT-SRV-ATTR:instance_name"`
+	TaskState          string               `json:"OS-EXT-STS:task_state"`
+	VMState            string               `json:"OS-EXT-STS:vm_state"`
+	PowerState         int                  `json:"OS-EXT-STS:power_state"`
+	LaunchedAt         string               `json:"OS-SRV-USG:launched_at"`
+	TerminatedAt       string               `json:"OS-SRV-USG:terminated_at"`
+	VolumesAttached    []Reference          `json:"os-extended-volumes:volumes_attached"`
+	Fault              *Fault               `json:"fault"`
+	Links              []Link               `json:"links"`
+}
+
+// Address fixed or floating ip of a server
+type Address struct {
+	Addr    string `json:"addr"`
+	Version int    `json:"version"`
+	Type    string `json:"OS-EXT-IPS:type"`
+	MACAddr string `json:"OS-EXT-IPS-MAC:mac_addr"`
+}
+
+// SecurityGroup security group by name
+type SecurityGroup struct {
+	Name string `json:"name"`
+}
+
+// Fault reason of a server in ERROR, implements error so it can be returned as is
+type Fault struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Details string `json:"details"`
+	Created string `json:"created"`
+}
+
+func (f *Fault) Error() string {
+	return fmt.Sprintf("nova: %s (%d)", f.Message, f.Code)
+}
+
+// ListServersOpts servers filters, Limit is the page size, all pages are always listed
+type ListServersOpts struct {
+	Name         string
+	Status       string
+	Image        string
+	Flavor       string
+	Host         string
+	IP           string
+	TenantID     string
+	ChangesSince string
+	AllTenants   bool
+	SortKey      string
+	SortDir      string
+	Limit        int
+}
+
+// ServerOpts create server fields, UserData is sent base64 encoded and FlavorName is resolved when FlavorRef is empty
+type ServerOpts struct {
+	Name                string                 `json:"name"`
+	ImageRef            string                 `json:"imageRef,omitempty"`
+	FlavorRef           string                 `json:"flavorRef"`
+	FlavorName          string                 `json:"-"`
+	Networks            []ServerNetwork        `json:"networks,omitempty"`
+	BlockDeviceMappings []BlockDeviceMapping   `json:"block_device_mapping_v2,omitempty"`
+	UserData            []byte                 `json:"user_data,omitempty"`
+	KeyName             string                 `json:"key_name,omitempty"`
+	SecurityGroups      []SecurityGroup        `json:"security_groups,omitempty"`
+	AvailabilityZone    string                 `json:"availability_zone,omitempty"`
+	Metadata            map[string]string      `json:"metadata,omitempty"`
+	ConfigDrive         bool                   `json:"config_drive,omitempty"`
+	AdminPass           string                 `json:"adminPass,omitempty"`
+	Description         string                 `json:"description,omitempty"`
+	MinCount            int                    `json:"min_count,omitempty"`
+	MaxCount            int                    `json:"max_count,omitempty"`
+	SchedulerHints      map[string]interface{} `json:"-"`
+}
+
+// ServerNetwork attach the server to a network UUID or an existing Port
+type ServerNetwork struct {
+	UUID    string `json:"uuid,omitempty"`
+	Port    string `json:"port,omitempty"`
+	FixedIP string `json:"fixed_ip,omitempty"`
+}
+
+// BlockDeviceMapping boot from volume or attach extra disks, SourceType is image, volume, snapshot or blank
+type BlockDeviceMapping struct {
+	BootIndex           *int   `json:"boot_index,omitempty"`
+	UUID                string `json:"uuid,omitempty"`
+	SourceType          string `json:"source_type"`
+	DestinationType     string `json:"destination_type,omitempty"`
+	VolumeSize          int    `json:"volume_size,omitempty"`
+	DeleteOnTermination bool   `json:"delete_on_termination,omitempty"`
+	DeviceName          string `json:"device_name,omitempty"`
+	DeviceType          string `json:"device_type,omitempty"`
+	DiskBus             string `json:"disk_bus,omitempty"`
+	GuestFormat         string `json:"guest_format,omitempty"`
+}
+
+type serverRequest struct {
+	Server         ServerOpts             `json:"server"`
+	SchedulerHints map[string]interface{} `json:"os:scheduler_hints,omitempty"`
+}
+
+// UpdateServerOpts update server fields, Description needs microversion 2.19
+type UpdateServerOpts struct {
+	Name        string `json:"name,omitempty"`
+	AccessIPv4  string `json:"accessIPv4,omitempty"`
+	AccessIPv6  string `json:"accessIPv6,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type updateServerRequest struct {
+	Server UpdateServerOpts `json:"server"`
+}
+
+type rebootRequest struct {
+	Type RebootType `json:"type"`
+}
+
+// ResizeOpts resize fields, DiskConfig is AUTO or MANUAL
+type ResizeOpts struct {
+	FlavorRef  string `json:"flavorRef"`
+	DiskConfig string `json:"OS-DCF:diskConfig,omitempty"`
+}
+
+// RebuildOpts rebuild fields, Description needs microversion 2.19
+type RebuildOpts struct {
+	ImageRef          string            `json:"imageRef"`
+	Name              string            `json:"name,omitempty"`
+	AdminPass         string            `json:"adminPass,omitempty"`
+	Metadata          map[string]string `json:"metadata,omitempty"`
+	PreserveEphemeral bool              `json:"preserve_ephemeral,omitempty"`
+	Description       string            `json:"description,omitempty"`
+}
+
+// RescueOpts rescue fields, the server image is used when RescueImageRef is empty
+type RescueOpts struct {
+	AdminPass      string `json:"adminPass,omitempty"`
+	RescueImageRef string `json:"rescue_image_ref,omitempty"`
+}
+
+type rescueResponse struct {
+	AdminPass string `json:"adminPass"`
+}
+
+type lockRequest struct {
+	LockedReason string `json:"locked_reason"`
+}
+
+// CreateImageOpts snapshot fields
+type CreateImageOpts struct {
+	Name     string            `json:"name"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+type createImageResponse struct {
+	ImageID string `json:"image_id"`
+}
+
+// LiveMigrateOpts live migration fields, BlockMigration nil lets nova decide
+type LiveMigrateOpts struct {
+	Host           string
+	BlockMigration *bool
+}
+
+type liveMigrateRequest struct {
+	Host           *string     `json:"host"`
+	BlockMigration interface{} `json:"block_migration"`
+}
+
+// MigrateOpts cold migration fields, Host needs microversion 2.56
+type MigrateOpts struct {
+	Host string `json:"host,omitempty"`
+}
+
+type instanceActionsResponse struct {
+	InstanceActions []InstanceAction `json:"instanceActions"`
+}
+
+type instanceActionResponse struct {
+	InstanceAction InstanceAction `json:"instanceAction"`
+}
+
+// InstanceAction action performed on a server, Events are only returned by GetInstanceAction,
+// UpdatedAt is empty on clouds older than microversion 2.58
+type InstanceAction struct {
+	Action       string                `json:"action"`
+	InstanceUUID string                `json:"instance_uuid"`
+	RequestID    string                `json:"request_id"`
+	UserID       string                `json:"user_id"`
+	ProjectID    string                `json:"project_id"`
+	Message      string                `json:"message"`
+	StartTime    string                `json:"start_time"`
+	UpdatedAt    string                `json:"updated_at"`
+	Events       []InstanceActionEvent `json:"events"`
+}
+
+// InstanceActionEvent step of an instance action, Result is Success or Error
+type InstanceActionEvent struct {
+	Event      string `json:"event"`
+	StartTime  string `json:"start_time"`
+	FinishTime string `json:"finish_time"`
+	Result     string `json:"result"`
+	Traceback  string `json:"traceback"`
+	Host       string `json:"host"`
+}
+
+// FlavorResponse list flavors type
+type FlavorResponse struct {
+	Flavors []Flavor `json:"flavors"`
+	Links   links    `json:"flavors_links"`
+}
+
+type flavorResponse struct {
+	Flavor Flavor `json:"flavor"`
+}
+
+// Flavor server size, RAM is in MiB and Disk in GiB
+type Flavor struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	RAM         int     `json:"ram"`
+	VCPUs       int     `json:"vcpus"`
+	Disk        int     `json:"disk"`
+	Ephemeral   int     `json:"OS-FLV-EXT-DATA:ephemeral"`
+	Swap        int     `json:"swap"`
+	RxTxFactor  float64 `json:"rxtx_factor"`
+	IsPublic    bool    `json:"os-flavor-access:is_public"`
+	Disabled    bool    `json:"OS-FLV-DISABLED:disabled"`
+	Description string  `json:"description"`
+	Links       []Link  `json:"links"`
+}
+
+// UnmarshalJSON before microversion 2.75 a flavor without swap returns an empty string
+func (f *Flavor) UnmarshalJSON(data []byte) error {
+	type flavor Flavor
+	var raw struct {
+		flavor
+		Swap interface{} `json:"swap"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*f = Flavor(raw.flavor)
+	if swap, ok := raw.Swap.(float64); ok {
+		f.Swap = int(swap)
+	}
+	return nil
+}
+
+// ListFlavorsOpts flavors filters, AllAccess lists public and private flavors for admins
+type ListFlavorsOpts struct {
+	IsPublic  *bool
+	AllAccess bool
+	MinDisk   int
+	MinRAM    int
+	SortKey   string
+	SortDir   string
+	Limit     int
+}
+
+// FlavorOpts create flavor fields, Description needs microversion 2.55
+type FlavorOpts struct {
+	ID          string  `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	RAM         int     `json:"ram"`
+	VCPUs       int     `json:"vcpus"`
+	Disk        int     `json:"disk"`
+	Ephemeral   int     `json:"OS-FLV-EXT-DATA:ephemeral,omitempty"`
+	Swap        int     `json:"swap,omitempty"`
+	RxTxFactor  float64 `json:"rxtx_factor,omitempty"`
+	IsPublic    *bool   `json:"os-flavor-access:is_public,omitempty"`
+	Description string  `json:"description,omitempty"`
+}
+
+type flavorRequest struct {
+	Flavor FlavorOpts `json:"flavor"`
+}
+
+type extraSpecsResponse struct {
+	ExtraSpecs map[string]string `json:"extra_specs"`
+}
+
+// FlavorAccess project allowed to use a private flavor
+type FlavorAccess struct {
+	FlavorID string `json:"flavor_id"`
+	TenantID string `json:"tenant_id"`
+}
+
+type flavorAccessResponse struct {
+	FlavorAccess []FlavorAccess `json:"flavor_access"`
+}
+
+type flavorAccessRequest struct {
+	Tenant string `json:"tenant"`
+}
+
+type keypairsResponse struct {
+	Keypairs []keypairResponse `json:"keypairs"`
+}
+
+type keypairResponse struct {
+	Keypair Keypair `json:"keypair"`
+}
+
+// Keypair ssh or x509 keypair, PrivateKey is only set when nova generated the keypair
+type Keypair struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	PublicKey   string `json:"public_key"`
+	PrivateKey  string `json:"private_key"`
+	Fingerprint string `json:"fingerprint"`
+	UserID      string `json:"user_id"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// KeypairOpts create keypair fields, Type is ssh or x509 and UserID is admin only
+type KeypairOpts struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"public_key,omitempty"`
+	Type      string `json:"type,omitempty"`
+	UserID    string `json:"user_id,omitempty"`
+}
+
+type keypairRequest struct {
+	Keypair KeypairOpts `json:"keypair"`
+}
+
+type serverGroupsResponse struct {
+	ServerGroups []ServerGroup `json:"server_groups"`
+}
+
+type serverGroupResponse struct {
+	ServerGroup ServerGroup `json:"server_group"`
+}
+
+// ServerGroup affinity or anti-affinity group, Members are server ids, Rules are only returned from 2.64 on
+type ServerGroup struct {
+	ID        string           `json:"id"`
+	Name      string           `json:"name"`
+	Policy    string           `json:"policy"`
+	Policies  []string         `json:"policies"`
+	Rules     ServerGroupRules `json:"rules"`
+	Members   []string         `json:"members"`
+	ProjectID string           `json:"project_id"`
+	UserID    string           `json:"user_id"`
+}
+
+// UnmarshalJSON before microversion 2.64 the policy is returned as a single element policies list
+func (g *ServerGroup) UnmarshalJSON(data []byte) error {
+	type serverGroup ServerGroup
+	if err := json.Unmarshal(data, (*serverGroup)(g)); err != nil {
+		return err
+	}
+
+	if g.Policy == "" && len(g.Policies) > 0 {
+		g.Policy = g.Policies[0]
+	}
+	return nil
+}
+
+// ServerGroupRules only valid with the AntiAffinity policy
+type ServerGroupRules struct {
+	MaxServerPerHost int `json:"max_server_per_host,omitempty"`
+}
+
+// ServerGroupOpts create server group fields, setting Rules requires nova 2.64
+type ServerGroupOpts struct {
+	Name   string            `json:"name"`
+	Policy string            `json:"policy"`
+	Rules  *ServerGroupRules `json:"rules,omitempty"`
+}
+
+type serverGroupRequest struct {
+	ServerGroup ServerGroupOpts `json:"server_group"`
+}
+
+type legacyServerGroupOpts struct {
+	Name     string   `json:"name"`
+	Policies []string `json:"policies"`
+}
+
+type legacyServerGroupRequest struct {
+	ServerGroup legacyServerGroupOpts `json:"server_group"`
+}
+
+type volumeAttachmentsResponse struct {
+	VolumeAttachments []VolumeAttachment `json:"volumeAttachments"`
+}
+
+type volumeAttachmentResponse struct {
+	VolumeAttachment VolumeAttachment `json:"volumeAttachment"`
+}
+
+// VolumeAttachment volume attached to a server, Device is e.g. /dev/vdb
+type VolumeAttachment struct {
+	ID                  string `json:"id"`
+	ServerID            string `json:"serverId"`
+	VolumeID            string `json:"volumeId"`
+	Device              string `json:"device"`
+	Tag                 string `json:"tag"`
+	DeleteOnTermination bool   `json:"delete_on_termination"`
+}
+
+// AttachVolumeOpts attach volume fields, nova picks the next free Device when empty
+type AttachVolumeOpts struct {
+	VolumeID string `json:"volumeId"`
+	Device   string `json:"device,omitempty"`
+}
+
+type volumeAttachmentRequest struct {
+	VolumeAttachment AttachVolumeOpts `json:"volumeAttachment"`
+}
+
+// HypervisorResponse list hypervisors type
+type HypervisorResponse struct {
+	Hypervisors []Hypervisor `json:"hypervisors"`
+	Links       links        `json:"hypervisors_links"`
+}
+
+type hypervisorResponse struct {
+	Hypervisor Hypervisor `json:"hypervisor"`
+}
+
+// Hypervisor compute node, memory is in MB and disk in GB
+type Hypervisor struct {
+	ID                 string             `json:"id"`
+	HypervisorHostname string             `json:"hypervisor_hostname"`
+	HypervisorType     string             `json:"hypervisor_type"`
+	HypervisorVersion  int                `json:"hypervisor_version"`
+	HostIP             string             `json:"host_ip"`
+	State              string             `json:"state"`
+	Status             string             `json:"status"`
+	VCPUs              int                `json:"vcpus"`
+	VCPUsUsed          int                `json:"vcpus_used"`
+	MemoryMB           int                `json:"memory_mb"`
+	MemoryMBUsed       int                `json:"memory_mb_used"`
+	FreeRAMMB          int                `json:"free_ram_mb"`
+	LocalGB            int                `json:"local_gb"`
+	LocalGBUsed        int                `json:"local_gb_used"`
+	FreeDiskGB         int                `json:"free_disk_gb"`
+	DiskAvailableLeast int                `json:"disk_available_least"`
+	RunningVMs         int                `json:"running_vms"`
+	CurrentWorkload    int                `json:"current_workload"`
+	Service            HypervisorService  `json:"service"`
+	Servers            []HypervisorServer `json:"servers"`
+}
+
+// HypervisorService compute service running the hypervisor
+type HypervisorService struct {
+	ID             string `json:"id"`
+	Host           string `json:"host"`
+	DisabledReason string `json:"disabled_reason"`
+}
+
+// HypervisorServer server running on the hypervisor, only listed WithServers
+type HypervisorServer struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+// ListHypervisorsOpts hypervisors filters, Limit is the page size, all pages are always listed
+type ListHypervisorsOpts struct {
+	HostnamePattern string
+	WithServers     bool
+	Limit           int
+}
+
+type hypervisorStatisticsResponse struct {
+	HypervisorStatistics HypervisorStatistics `json:"hypervisor_statistics"`
+}
+
+// HypervisorStatistics resources summed over all hypervisors
+type HypervisorStatistics struct {
+	Count              int `json:"count"`
+	VCPUs              int `json:"vcpus"`
+	VCPUsUsed          int `json:"vcpus_used"`
+	MemoryMB           int `json:"memory_mb"`
+	MemoryMBUsed       int `json:"memory_mb_used"`
+	FreeRAMMB          int `json:"free_ram_mb"`
+	LocalGB            int `json:"local_gb"`
+	LocalGBUsed        int `json:"local_gb_used"`
+	FreeDiskGB         int `json:"free_disk_gb"`
+	DiskAvailableLeast int `json:"disk_available_least"`
+	RunningVMs         int `json:"running_vms"`
+	CurrentWorkload    int `json:"current_workload"`
+}
+
+type aggregatesResponse struct {
+	Aggregates []Aggregate `json:"aggregates"`
+}
+
+type aggregateResponse struct {
+	Aggregate Aggregate `json:"aggregate"`
+}
+
+// Aggregate group of compute hosts sharing metadata used by the scheduler
+type Aggregate struct {
+	ID               int               `json:"id"`
+	UUID             string            `json:"uuid"`
+	Name             string            `json:"name"`
+	AvailabilityZone string            `json:"availability_zone"`
+	Hosts            []string          `json:"hosts"`
+	Metadata         map[string]string `json:"metadata"`
+	CreatedAt        string            `json:"created_at"`
+	UpdatedAt        string            `json:"updated_at"`
+}
+
+// AggregateOpts create and update aggregate fields
+type AggregateOpts struct {
+	Name             string `json:"name,omitempty"`
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+type aggregateRequest struct {
+	Aggregate AggregateOpts `json:"aggregate"`
+}
+
+type aggregateHostRequest struct {
+	Host string `json:"host"`
+}
+
+type aggregateMetadataRequest struct {
+	Metadata map[string]*string `json:"metadata"`
+}
+
+type servicesResponse struct {
+	Services []Service `json:"services"`
+}
+
+type serviceResponse struct {
+	Service Service `json:"service"`
+}
+
+// Service compute service e.g. nova-compute, State is up or down and Status enabled or disabled
+type Service struct {
+	ID             string `json:"id"`
+	Binary         string `json:"binary"`
+	Host           string `json:"host"`
+	Zone           string `json:"zone"`
+	Status         string `json:"status"`
+	State          string `json:"state"`
+	DisabledReason string `json:"disabled_reason"`
+	ForcedDown     bool   `json:"forced_down"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+type serviceRequest struct {
+	Status         string `json:"status,omitempty"`
+	DisabledReason string `json:"disabled_reason,omitempty"`
+	ForcedDown     *bool  `json:"forced_down,omitempty"`
+}
+
+type availabilityZonesResponse struct {
+	AvailabilityZones []AvailabilityZone `json:"availabilityZoneInfo"`
+}
+
+// AvailabilityZone Hosts maps host names to their services, only set by the detailed listing
+type AvailabilityZone struct {
+	ZoneName  string                             `json:"zoneName"`
+	ZoneState ZoneState                          `json:"zoneState"`
+	Hosts     map[string]map[string]ServiceState `json:"hosts"`
+}
+
+// ZoneState availability of a zone
+type ZoneState struct {
+	Available bool `json:"available"`
+}
+
+// ServiceState state of a service in an availability zone
+type ServiceState struct {
+	Available bool   `json:"available"`
+	Active    bool   `json:"active"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type quotaSetResponse struct {
+	QuotaSet QuotaSet `json:"quota_set"`
+}
+
+// QuotaSet compute quotas of a project, -1 is unlimited and RAM is in MiB
+type QuotaSet struct {
+	ID                       string `json:"id"`
+	Instances                int    `json:"instances"`
+	Cores                    int    `json:"cores"`
+	RAM                      int    `json:"ram"`
+	KeyPairs                 int    `json:"key_pairs"`
+	MetadataItems            int    `json:"metadata_items"`
+	ServerGroups             int    `json:"server_groups"`
+	ServerGroupMembers       int    `json:"server_group_members"`
+	InjectedFiles            int    `json:"injected_files"`
+	InjectedFileContentBytes int    `json:"injected_file_content_bytes"`
+	InjectedFilePathBytes    int    `json:"injected_file_path_bytes"`
+}
+
+// QuotaSetOpts update quota fields, only the quotas which are set are changed
+type QuotaSetOpts struct {
+	Instances                *int `json:"instances,omitempty"`
+	Cores                    *int `json:"cores,omitempty"`
+	RAM                      *int `json:"ram,omitempty"`
+	KeyPairs                 *int `json:"key_pairs,omitempty"`
+	MetadataItems            *int `json:"metadata_items,omitempty"`
+	ServerGroups             *int `json:"server_groups,omitempty"`
+	ServerGroupMembers       *int `json:"server_group_members,omitempty"`
+	InjectedFiles            *int `json:"injected_files,omitempty"`
+	InjectedFileContentBytes *int `json:"injected_file_content_bytes,omitempty"`
+	InjectedFilePathBytes    *int `json:"injected_file_path_bytes,omitempty"`
+	Force                    bool `json:"force,omitempty"`
+}
+
+type quotaSetRequest struct {
+	QuotaSet QuotaSetOpts `json:"quota_set"`
+}
+
+type quotaSetDetailResponse struct {
+	QuotaSet QuotaSetDetail `json:"quota_set"`
+}
+
+// QuotaSetDetail compute quotas of a project with their usage
+type QuotaSetDetail struct {
+	ID                       string      `json:"id"`
+	Instances                QuotaDetail `json:"instances"`
+	Cores                    QuotaDetail `json:"cores"`
+	RAM                      QuotaDetail `json:"ram"`
+	KeyPairs                 QuotaDetail `json:"key_pairs"`
+	MetadataItems            QuotaDetail `json:"metadata_items"`
+	ServerGroups             QuotaDetail `json:"server_groups"`
+	ServerGroupMembers       QuotaDetail `json:"server_group_members"`
+	InjectedFiles            QuotaDetail `json:"injected_files"`
+	InjectedFileContentBytes QuotaDetail `json:"injected_file_content_bytes"`
+	InjectedFilePathBytes    QuotaDetail `json:"injected_file_path_bytes"`
+}
+
+// QuotaDetail limit, usage and reservations of a single quota
+type QuotaDetail struct {
+	Limit    int `json:"limit"`
+	InUse    int `json:"in_use"`
+	Reserved int `json:"reserved"`
+}
+
+type limitsResponse struct {
+	Limits struct {
+		Absolute AbsoluteLimits `json:"absolute"`
+	} `json:"limits"`
+}
+
+// AbsoluteLimits quotas and usage of the project as returned by /limits
+type AbsoluteLimits struct {
+	MaxTotalInstances       int `json:"maxTotalInstances"`
+	MaxTotalCores           int `json:"maxTotalCores"`
+	MaxTotalRAMSize         int `json:"maxTotalRAMSize"`
+	MaxTotalKeypairs        int `json:"maxTotalKeypairs"`
+	MaxServerMeta           int `json:"maxServerMeta"`
+	MaxServerGroups         int `json:"maxServerGroups"`
+	MaxServerGroupMembers   int `json:"maxServerGroupMembers"`
+	MaxImageMeta            int `json:"maxImageMeta"`
+	MaxPersonality          int `json:"maxPersonality"`
+	MaxPersonalitySize      int `json:"maxPersonalitySize"`
+	TotalInstancesUsed      int `json:"totalInstancesUsed"`
+	TotalCoresUsed          int `json:"totalCoresUsed"`
+	TotalRAMUsed            int `json:"totalRAMUsed"`
+	TotalServerGroupsUsed   int `json:"totalServerGroupsUsed"`
+	TotalFloatingIpsUsed    int `json:"totalFloatingIpsUsed"`
+	TotalSecurityGroupsUsed int `json:"totalSecurityGroupsUsed"`
+}
+
+// UsageOpts usage period, Detailed adds the server usages when listing all projects
+type UsageOpts struct {
+	Start    time.Time
+	End      time.Time
+	Detailed bool
+	Limit    int
+}
+
+type tenantUsagesResponse struct {
+	TenantUsages []TenantUsage `json:"tenant_usages"`
+	Links        links         `json:"tenant_usages_links"`
+}
+
+type tenantUsageResponse struct {
+	TenantUsage TenantUsage `json:"tenant_usage"`
+	Links       links       `json:"tenant_usage_links"`
+}
+
+// TenantUsage usage of a project over the period, usages are in hours e.g. TotalVCPUsUsage is vcpu hours
+type TenantUsage struct {
+	TenantID           string        `json:"tenant_id"`
+	Start              string        `json:"start"`
+	Stop               string        `json:"stop"`
+	TotalHours         float64       `json:"total_hours"`
+	TotalLocalGBUsage  float64       `json:"total_local_gb_usage"`
+	TotalMemoryMBUsage float64       `json:"total_memory_mb_usage"`
+	TotalVCPUsUsage    float64       `json:"total_vcpus_usage"`
+	ServerUsages       []ServerUsage `json:"server_usages"`
+}
+
+// ServerUsage usage of a single server over the period, EndedAt is empty for running servers
+type ServerUsage struct {
+	InstanceID string  `json:"instance_id"`
+	Name       string  `json:"name"`
+	TenantID   string  `json:"tenant_id"`
+	Flavor     string  `json:"flavor"`
+	Hours      float64 `json:"hours"`
+	VCPUs      int     `json:"vcpus"`
+	MemoryMB   int     `json:"memory_mb"`
+	LocalGB    int     `json:"local_gb"`
+	State      string  `json:"state"`
+	StartedAt  string  `json:"started_at"`
+	EndedAt    string  `json:"ended_at"`
+	Uptime     int     `json:"uptime"`
+}
+
+type consoleOutputRequest struct {
+	Length *int `json:"length,omitempty"`
+}
+
+type consoleOutputResponse struct {
+	Output string `json:"output"`
+}
+
+// RemoteConsoleOpts console protocol and type e.g. vnc and novnc or serial and serial
+type RemoteConsoleOpts struct {
+	Protocol string `json:"protocol"`
+	Type     string `json:"type"`
+}
+
+type remoteConsoleRequest struct {
+	RemoteConsole RemoteConsoleOpts `json:"remote_console"`
+}
+
+type remoteConsoleResponse struct {
+	RemoteConsole RemoteConsole `json:"remote_console"`
+}
+
+// RemoteConsole console url, it contains a short lived access token
+type RemoteConsole struct {
+	Protocol string `json:"protocol"`
+	Type     string `json:"type"`
+	URL      string `json:"url"`
+}
@@ -0,0 +1,194 @@
+package nova
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const flavorsPath = "/flavors"
+const flavorsDetailPath = "/flavors/detail"
+const flavorPath = "/flavors/$id"
+const flavorActionPath = "/flavors/$id/action"
+const flavorAccessPath = "/flavors/$id/os-flavor-access"
+const extraSpecsPath = "/flavors/$id/os-extra_specs"
+const extraSpecPath = "/flavors/$id/os-extra_specs/$key"
+
+const flavorDescriptionMicroversion = "compute 2.55" // flavor description was added in 2.55
+
+// ListFlavors list flavors with details following the next links of every page
+func (n *nova) ListFlavors(opts ListFlavorsOpts) (flavors []Flavor, err error) {
+	path := n.endpoint() + flavorsDetailPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse FlavorResponse
+		err = n.do("GET", path, "ListFlavors", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		flavors = append(flavors, jsonResponse.Flavors...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetFlavor get flavor by id
+func (n *nova) GetFlavor(flavorID string) (flavor Flavor, err error) {
+	path := n.endpoint() + strings.Replace(flavorPath, "$id", flavorID, -1)
+
+	var jsonResponse flavorResponse
+	err = n.do("GET", path, "GetFlavor", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	flavor = jsonResponse.Flavor
+	return
+}
+
+// FindFlavor resolve a flavor by its exact name among public and accessible private flavors
+func (n *nova) FindFlavor(name string) (flavor Flavor, err error) {
+	flavors, err := n.ListFlavors(ListFlavorsOpts{AllAccess: true})
+	if err != nil {
+		return
+	}
+
+	var found []Flavor
+	for _, f := range flavors {
+		if f.Name == name {
+			found = append(found, f)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		err = fmt.Errorf("nova: flavor %s not found", name)
+	case 1:
+		flavor = found[0]
+	default:
+		err = fmt.Errorf("nova: %d flavors are named %s", len(found), name)
+	}
+	return
+}
+
+// CreateFlavor create a flavor, nova generates the id when opts.ID is empty
+func (n *nova) CreateFlavor(opts FlavorOpts) (flavor Flavor, err error) {
+	path := n.endpoint() + flavorsPath
+
+	req, err := n.request("POST", path, "CreateFlavor", flavorRequest{Flavor: opts})
+	if err != nil {
+		return
+	}
+	if opts.Description != "" {
+		req.Header(apiVersionHeader, flavorDescriptionMicroversion)
+	}
+
+	var jsonResponse flavorResponse
+	_, err = n.exec(req, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	flavor = jsonResponse.Flavor
+	return
+}
+
+// DeleteFlavor delete flavor by id, servers using it keep running
+func (n *nova) DeleteFlavor(flavorID string) (err error) {
+	path := n.endpoint() + strings.Replace(flavorPath, "$id", flavorID, -1)
+	return n.do("DELETE", path, "DeleteFlavor", nil, nil)
+}
+
+// ListExtraSpecs list the extra specs of a flavor
+func (n *nova) ListExtraSpecs(flavorID string) (specs map[string]string, err error) {
+	path := n.endpoint() + strings.Replace(extraSpecsPath, "$id", flavorID, -1)
+
+	var jsonResponse extraSpecsResponse
+	err = n.do("GET", path, "ListExtraSpecs", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	specs = jsonResponse.ExtraSpecs
+	return
+}
+
+// SetExtraSpecs create or overwrite the given extra specs, other keys are kept
+func (n *nova) SetExtraSpecs(flavorID string, specs map[string]string) (err error) {
+	path := n.endpoint() + strings.Replace(extraSpecsPath, "$id", flavorID, -1)
+	return n.do("POST", path, "SetExtraSpecs", extraSpecsResponse{ExtraSpecs: specs}, nil)
+}
+
+// DeleteExtraSpec delete a single extra spec key
+func (n *nova) DeleteExtraSpec(flavorID, key string) (err error) {
+	path := strings.Replace(extraSpecPath, "$id", flavorID, -1)
+	path = n.endpoint() + strings.Replace(path, "$key", key, -1)
+	return n.do("DELETE", path, "DeleteExtraSpec", nil, nil)
+}
+
+// ListFlavorAccess list the projects allowed to use a private flavor
+func (n *nova) ListFlavorAccess(flavorID string) (access []FlavorAccess, err error) {
+	path := n.endpoint() + strings.Replace(flavorAccessPath, "$id", flavorID, -1)
+
+	var jsonResponse flavorAccessResponse
+	err = n.do("GET", path, "ListFlavorAccess", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	access = jsonResponse.FlavorAccess
+	return
+}
+
+// AddFlavorAccess allow the project to use a private flavor
+func (n *nova) AddFlavorAccess(flavorID, projectID string) (access []FlavorAccess, err error) {
+	return n.flavorAccessAction(flavorID, "AddFlavorAccess", "addTenantAccess", projectID)
+}
+
+// RemoveFlavorAccess revoke the access of the project to a private flavor
+func (n *nova) RemoveFlavorAccess(flavorID, projectID string) (access []FlavorAccess, err error) {
+	return n.flavorAccessAction(flavorID, "RemoveFlavorAccess", "removeTenantAccess", projectID)
+}
+
+func (n *nova) flavorAccessAction(flavorID, operation, name, projectID string) (access []FlavorAccess, err error) {
+	path := n.endpoint() + strings.Replace(flavorActionPath, "$id", flavorID, -1)
+
+	var jsonResponse flavorAccessResponse
+	err = n.do("POST", path, operation, map[string]flavorAccessRequest{name: {Tenant: projectID}}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	access = jsonResponse.FlavorAccess
+	return
+}
+
+func (o ListFlavorsOpts) query() string {
+	query := url.Values{}
+	if o.AllAccess {
+		query.Set("is_public", "None")
+	} else if o.IsPublic != nil {
+		query.Set("is_public", strconv.FormatBool(*o.IsPublic))
+	}
+	if o.MinDisk > 0 {
+		query.Set("minDisk", strconv.Itoa(o.MinDisk))
+	}
+	if o.MinRAM > 0 {
+		query.Set("minRam", strconv.Itoa(o.MinRAM))
+	}
+	if o.SortKey != "" {
+		query.Set("sort_key", o.SortKey)
+	}
+	if o.SortDir != "" {
+		query.Set("sort_dir", o.SortDir)
+	}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return query.Encode()
+}
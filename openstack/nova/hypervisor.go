@@ -0,0 +1,93 @@
+package nova
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Buni/openstack-client/openstack/client"
+)
+
+const hypervisorsPath = "/os-hypervisors/detail"
+const hypervisorPath = "/os-hypervisors/$id"
+const hypervisorStatisticsPath = "/os-hypervisors/statistics"
+
+const uuidMicroversion = "compute 2.53" // hypervisor and service ids are uuids since 2.53
+
+// ListHypervisors list hypervisors with details following the next links of every page
+func (n *nova) ListHypervisors(opts ListHypervisorsOpts) (hypervisors []Hypervisor, err error) {
+	path := n.endpoint() + hypervisorsPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var req *client.Request
+		req, err = n.request("GET", path, "ListHypervisors", nil)
+		if err != nil {
+			return
+		}
+
+		var jsonResponse HypervisorResponse
+		_, err = n.exec(req.Header(apiVersionHeader, uuidMicroversion), &jsonResponse)
+		if err != nil {
+			return
+		}
+		hypervisors = append(hypervisors, jsonResponse.Hypervisors...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetHypervisor get hypervisor by id
+func (n *nova) GetHypervisor(hypervisorID string) (hypervisor Hypervisor, err error) {
+	path := n.endpoint() + strings.Replace(hypervisorPath, "$id", hypervisorID, -1)
+
+	req, err := n.request("GET", path, "GetHypervisor", nil)
+	if err != nil {
+		return
+	}
+
+	var jsonResponse hypervisorResponse
+	_, err = n.exec(req.Header(apiVersionHeader, uuidMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	hypervisor = jsonResponse.Hypervisor
+	return
+}
+
+// GetHypervisorStatistics sum of the resources of all hypervisors
+func (n *nova) GetHypervisorStatistics() (statistics HypervisorStatistics, err error) {
+	path := n.endpoint() + hypervisorStatisticsPath
+
+	req, err := n.request("GET", path, "GetHypervisorStatistics", nil)
+	if err != nil {
+		return
+	}
+
+	var jsonResponse hypervisorStatisticsResponse
+	_, err = n.exec(req.Header(apiVersionHeader, uuidMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	statistics = jsonResponse.HypervisorStatistics
+	return
+}
+
+func (o ListHypervisorsOpts) query() string {
+	query := url.Values{}
+	if o.HostnamePattern != "" {
+		query.Set("hypervisor_hostname_pattern", o.HostnamePattern)
+	}
+	if o.WithServers {
+		query.Set("with_servers", "true")
+	}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return query.Encode()
+}
@@ -0,0 +1,79 @@
+package nova
+
+import (
+	"net/url"
+	"strings"
+)
+
+const keypairsPath = "/os-keypairs"
+const keypairPath = "/os-keypairs/$name"
+
+const keypairMicroversion = "compute 2.10" // keypair type was added in 2.2 and user_id in 2.10
+
+// ListKeypairs list the keypairs of userID, empty for the current user
+func (n *nova) ListKeypairs(userID string) (keypairs []Keypair, err error) {
+	path := n.endpoint() + keypairsPath
+	if userID != "" {
+		path += "?" + url.Values{"user_id": {userID}}.Encode()
+	}
+
+	req, err := n.request("GET", path, "ListKeypairs", nil)
+	if err != nil {
+		return
+	}
+
+	var jsonResponse keypairsResponse
+	_, err = n.exec(req.Header(apiVersionHeader, keypairMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	for _, keypair := range jsonResponse.Keypairs {
+		keypairs = append(keypairs, keypair.Keypair)
+	}
+	return
+}
+
+// GetKeypair get keypair by name
+func (n *nova) GetKeypair(name string) (keypair Keypair, err error) {
+	path := n.endpoint() + strings.Replace(keypairPath, "$name", url.PathEscape(name), -1)
+
+	req, err := n.request("GET", path, "GetKeypair", nil)
+	if err != nil {
+		return
+	}
+
+	var jsonResponse keypairResponse
+	_, err = n.exec(req.Header(apiVersionHeader, keypairMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	keypair = jsonResponse.Keypair
+	return
+}
+
+// CreateKeypair import opts.PublicKey or let nova generate a keypair, only then PrivateKey is returned
+func (n *nova) CreateKeypair(opts KeypairOpts) (keypair Keypair, err error) {
+	path := n.endpoint() + keypairsPath
+
+	req, err := n.request("POST", path, "CreateKeypair", keypairRequest{Keypair: opts})
+	if err != nil {
+		return
+	}
+
+	var jsonResponse keypairResponse
+	_, err = n.exec(req.Header(apiVersionHeader, keypairMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	keypair = jsonResponse.Keypair
+	return
+}
+
+// DeleteKeypair delete keypair by name
+func (n *nova) DeleteKeypair(name string) (err error) {
+	path := n.endpoint() + strings.Replace(keypairPath, "$name", url.PathEscape(name), -1)
+	return n.do("DELETE", path, "DeleteKeypair", nil, nil)
+}
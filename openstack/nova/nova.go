@@ -0,0 +1,170 @@
+package nova
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/Buni/openstack-client/openstack/cinder"
+	"github.com/Buni/openstack-client/openstack/client"
+	log "github.com/sirupsen/logrus"
+)
+
+// Nova Client
+type nova struct {
+	Client client.Client
+}
+
+// Nova interface
+type Nova interface {
+	ListServers(opts ListServersOpts) (servers []Server, err error)
+	GetServer(serverID string) (server Server, err error)
+	CreateServer(opts ServerOpts) (server Server, err error)
+	UpdateServer(serverID string, opts UpdateServerOpts) (server Server, err error)
+	DeleteServer(serverID string) (err error)
+	WaitForServerStatus(serverID, status string, timeout time.Duration) (server Server, err error)
+
+	StartServer(serverID string) (err error)
+	StopServer(serverID string) (err error)
+	RebootServer(serverID string, rebootType RebootType) (err error)
+	PauseServer(serverID string) (err error)
+	UnpauseServer(serverID string) (err error)
+	SuspendServer(serverID string) (err error)
+	ResumeServer(serverID string) (err error)
+	ShelveServer(serverID string) (err error)
+	UnshelveServer(serverID string) (err error)
+	ResizeServer(serverID string, opts ResizeOpts) (err error)
+	ConfirmResize(serverID string) (err error)
+	RevertResize(serverID string) (err error)
+	RebuildServer(serverID string, opts RebuildOpts) (server Server, err error)
+	RescueServer(serverID string, opts RescueOpts) (adminPass string, err error)
+	UnrescueServer(serverID string) (err error)
+	LockServer(serverID, reason string) (err error)
+	UnlockServer(serverID string) (err error)
+	CreateServerImage(serverID string, opts CreateImageOpts) (imageID string, err error)
+	LiveMigrateServer(serverID string, opts LiveMigrateOpts) (err error)
+	MigrateServer(serverID string, opts MigrateOpts) (err error)
+	ListInstanceActions(serverID string) (actions []InstanceAction, err error)
+	GetInstanceAction(serverID, requestID string) (action InstanceAction, err error)
+
+	ListFlavors(opts ListFlavorsOpts) (flavors []Flavor, err error)
+	GetFlavor(flavorID string) (flavor Flavor, err error)
+	FindFlavor(name string) (flavor Flavor, err error)
+	CreateFlavor(opts FlavorOpts) (flavor Flavor, err error)
+	DeleteFlavor(flavorID string) (err error)
+	ListExtraSpecs(flavorID string) (specs map[string]string, err error)
+	SetExtraSpecs(flavorID string, specs map[string]string) (err error)
+	DeleteExtraSpec(flavorID, key string) (err error)
+	ListFlavorAccess(flavorID string) (access []FlavorAccess, err error)
+	AddFlavorAccess(flavorID, projectID string) (access []FlavorAccess, err error)
+	RemoveFlavorAccess(flavorID, projectID string) (access []FlavorAccess, err error)
+
+	ListKeypairs(userID string) (keypairs []Keypair, err error)
+	GetKeypair(name string) (keypair Keypair, err error)
+	CreateKeypair(opts KeypairOpts) (keypair Keypair, err error)
+	DeleteKeypair(name string) (err error)
+
+	ListServerGroups(allProjects bool) (groups []ServerGroup, err error)
+	GetServerGroup(groupID string) (group ServerGroup, err error)
+	CreateServerGroup(opts ServerGroupOpts) (group ServerGroup, err error)
+	DeleteServerGroup(groupID string) (err error)
+
+	AttachVolume(serverID string, opts AttachVolumeOpts) (attachment VolumeAttachment, err error)
+	DetachVolume(serverID, volumeID string) (err error)
+	ListAttachments(serverID string) (attachments []VolumeAttachment, err error)
+	CreateAndAttachVolume(serverID string, volumes cinder.Cinder, opts cinder.VolumeOpts, timeout time.Duration) (attachment VolumeAttachment, err error)
+
+	ListHypervisors(opts ListHypervisorsOpts) (hypervisors []Hypervisor, err error)
+	GetHypervisor(hypervisorID string) (hypervisor Hypervisor, err error)
+	GetHypervisorStatistics() (statistics HypervisorStatistics, err error)
+
+	ListAggregates() (aggregates []Aggregate, err error)
+	GetAggregate(aggregateID int) (aggregate Aggregate, err error)
+	CreateAggregate(opts AggregateOpts) (aggregate Aggregate, err error)
+	UpdateAggregate(aggregateID int, opts AggregateOpts) (aggregate Aggregate, err error)
+	DeleteAggregate(aggregateID int) (err error)
+	AddAggregateHost(aggregateID int, host string) (aggregate Aggregate, err error)
+	RemoveAggregateHost(aggregateID int, host string) (aggregate Aggregate, err error)
+	SetAggregateMetadata(aggregateID int, metadata map[string]string) (aggregate Aggregate, err error)
+	DeleteAggregateMetadata(aggregateID int, keys ...string) (aggregate Aggregate, err error)
+
+	ListAvailabilityZones(detail bool) (zones []AvailabilityZone, err error)
+	ListServices(host, binary string) (services []Service, err error)
+	EnableService(serviceID string) (service Service, err error)
+	DisableService(serviceID, reason string) (service Service, err error)
+	ForceDownService(serviceID string, forcedDown bool) (service Service, err error)
+
+	GetQuotaSet(projectID, userID string) (quotas QuotaSet, err error)
+	UpdateQuotaSet(projectID, userID string, opts QuotaSetOpts) (quotas QuotaSet, err error)
+	GetDefaultQuotaSet(projectID string) (quotas QuotaSet, err error)
+	GetQuotaSetDetail(projectID, userID string) (quotas QuotaSetDetail, err error)
+	GetLimits(projectID string) (limits AbsoluteLimits, err error)
+	ListTenantUsage(opts UsageOpts) (usages []TenantUsage, err error)
+	GetTenantUsage(projectID string, opts UsageOpts) (usage TenantUsage, err error)
+
+	GetConsoleOutput(serverID string, lines int) (output string, err error)
+	CreateRemoteConsole(serverID string, opts RemoteConsoleOpts) (console RemoteConsole, err error)
+}
+
+const apiVersionHeader = "OpenStack-API-Version"
+
+// New Nova
+func New(authClient client.Client) Nova {
+	return &nova{Client: authClient}
+}
+
+func (n *nova) endpoint() string {
+	return n.Client.GetEndpoint("nova")
+}
+
+// do marshal in as the request body, execute the request and unmarshal the response body into out
+func (n *nova) do(method, path, operation string, in, out interface{}) (err error) {
+	req, err := n.request(method, path, operation, in)
+	if err != nil {
+		return
+	}
+
+	_, err = n.exec(req, out)
+	return
+}
+
+// request prepare a request, used directly when a microversion header is needed
+func (n *nova) request(method, path, operation string, in interface{}) (req *client.Request, err error) {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewBuffer(payload)
+	}
+
+	req = n.Client.NewRequest(path, method, body).MetaData("nova", operation)
+	return
+}
+
+// exec execute an already prepared request, the returned response body is already closed
+func (n *nova) exec(req *client.Request, out interface{}) (resp *http.Response, err error) {
+	resp, err = req.Context(context.TODO()).Do()
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+	log.Debugln(string(respBody))
+
+	if out == nil || len(respBody) == 0 {
+		return
+	}
+
+	err = json.Unmarshal(respBody, out)
+	return
+}
@@ -0,0 +1,91 @@
+package nova
+
+import (
+	"net/url"
+	"strings"
+)
+
+const quotaSetPath = "/os-quota-sets/$id"
+const quotaSetDefaultsPath = "/os-quota-sets/$id/defaults"
+const quotaSetDetailPath = "/os-quota-sets/$id/detail"
+const limitsPath = "/limits"
+
+// GetQuotaSet get the quotas of the project, userID is optional to get the quotas of a user in the project
+func (n *nova) GetQuotaSet(projectID, userID string) (quotas QuotaSet, err error) {
+	path := n.endpoint() + strings.Replace(quotaSetPath, "$id", projectID, -1) + userQuery(userID)
+
+	var jsonResponse quotaSetResponse
+	err = n.do("GET", path, "GetQuotaSet", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	quotas = jsonResponse.QuotaSet
+	return
+}
+
+// UpdateQuotaSet update only the quotas set in opts, Force allows a limit below the current usage
+func (n *nova) UpdateQuotaSet(projectID, userID string, opts QuotaSetOpts) (quotas QuotaSet, err error) {
+	path := n.endpoint() + strings.Replace(quotaSetPath, "$id", projectID, -1) + userQuery(userID)
+
+	var jsonResponse quotaSetResponse
+	err = n.do("PUT", path, "UpdateQuotaSet", quotaSetRequest{QuotaSet: opts}, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	quotas = jsonResponse.QuotaSet
+	return
+}
+
+// GetDefaultQuotaSet get the default quotas applied to projects without explicit quotas
+func (n *nova) GetDefaultQuotaSet(projectID string) (quotas QuotaSet, err error) {
+	path := n.endpoint() + strings.Replace(quotaSetDefaultsPath, "$id", projectID, -1)
+
+	var jsonResponse quotaSetResponse
+	err = n.do("GET", path, "GetDefaultQuotaSet", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	quotas = jsonResponse.QuotaSet
+	return
+}
+
+// GetQuotaSetDetail get the quotas of the project together with their usage and reservations
+func (n *nova) GetQuotaSetDetail(projectID, userID string) (quotas QuotaSetDetail, err error) {
+	path := n.endpoint() + strings.Replace(quotaSetDetailPath, "$id", projectID, -1) + userQuery(userID)
+
+	var jsonResponse quotaSetDetailResponse
+	err = n.do("GET", path, "GetQuotaSetDetail", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	quotas = jsonResponse.QuotaSet
+	return
+}
+
+// GetLimits get the absolute limits and usage of the current project, admins can pass another projectID
+func (n *nova) GetLimits(projectID string) (limits AbsoluteLimits, err error) {
+	path := n.endpoint() + limitsPath
+	if projectID != "" {
+		path += "?" + url.Values{"tenant_id": {projectID}}.Encode()
+	}
+
+	var jsonResponse limitsResponse
+	err = n.do("GET", path, "GetLimits", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	limits = jsonResponse.Limits.Absolute
+	return
+}
+
+func userQuery(userID string) string {
+	if userID == "" {
+		return ""
+	}
+	return "?" + url.Values{"user_id": {userID}}.Encode()
+}
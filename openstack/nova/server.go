@@ -0,0 +1,188 @@
+package nova
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Buni/openstack-client/openstack/client"
+)
+
+const serversPath = "/servers"
+const serversDetailPath = "/servers/detail"
+const serverPath = "/servers/$id"
+
+const descriptionMicroversion = "compute 2.19" // server description was added in 2.19
+
+// StatusDeleted target status of WaitForServerStatus to wait until the server is gone
+const StatusDeleted = "DELETED"
+
+const pollInterval = 2 * time.Second
+
+// ListServers list servers with details following the next links of every page
+func (n *nova) ListServers(opts ListServersOpts) (servers []Server, err error) {
+	path := n.endpoint() + serversDetailPath
+	if query := opts.query(); query != "" {
+		path += "?" + query
+	}
+
+	for path != "" {
+		var jsonResponse ServerResponse
+		err = n.do("GET", path, "ListServers", nil, &jsonResponse)
+		if err != nil {
+			return
+		}
+		servers = append(servers, jsonResponse.Servers...)
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetServer get server by id
+func (n *nova) GetServer(serverID string) (server Server, err error) {
+	path := n.endpoint() + strings.Replace(serverPath, "$id", serverID, -1)
+
+	var jsonResponse serverResponse
+	err = n.do("GET", path, "GetServer", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	server = jsonResponse.Server
+	return
+}
+
+// CreateServer boot a server, the returned server only holds the id, links and admin password
+func (n *nova) CreateServer(opts ServerOpts) (server Server, err error) {
+	path := n.endpoint() + serversPath
+
+	if opts.FlavorRef == "" && opts.FlavorName != "" {
+		var flavor Flavor
+		flavor, err = n.FindFlavor(opts.FlavorName)
+		if err != nil {
+			return
+		}
+		opts.FlavorRef = flavor.ID
+	}
+
+	req, err := n.request("POST", path, "CreateServer", serverRequest{Server: opts, SchedulerHints: opts.SchedulerHints})
+	if err != nil {
+		return
+	}
+	if opts.Description != "" {
+		req.Header(apiVersionHeader, descriptionMicroversion)
+	}
+
+	var jsonResponse serverResponse
+	_, err = n.exec(req, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	server = jsonResponse.Server
+	return
+}
+
+// UpdateServer update only the fields set in opts
+func (n *nova) UpdateServer(serverID string, opts UpdateServerOpts) (server Server, err error) {
+	path := n.endpoint() + strings.Replace(serverPath, "$id", serverID, -1)
+
+	req, err := n.request("PUT", path, "UpdateServer", updateServerRequest{Server: opts})
+	if err != nil {
+		return
+	}
+	if opts.Description != "" {
+		req.Header(apiVersionHeader, descriptionMicroversion)
+	}
+
+	var jsonResponse serverResponse
+	_, err = n.exec(req, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	server = jsonResponse.Server
+	return
+}
+
+// DeleteServer delete server by id, use WaitForServerStatus with StatusDeleted to wait for the removal
+func (n *nova) DeleteServer(serverID string) (err error) {
+	path := n.endpoint() + strings.Replace(serverPath, "$id", serverID, -1)
+	return n.do("DELETE", path, "DeleteServer", nil, nil)
+}
+
+// WaitForServerStatus poll the server until it reaches status, a server going to ERROR returns its fault
+func (n *nova) WaitForServerStatus(serverID, status string, timeout time.Duration) (server Server, err error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		server, err = n.GetServer(serverID)
+		if err != nil {
+			if status == StatusDeleted && client.IsNotFound(err) {
+				err = nil
+			}
+			return
+		}
+
+		if strings.EqualFold(server.Status, status) {
+			return
+		}
+		if strings.EqualFold(server.Status, "ERROR") {
+			if server.Fault != nil {
+				err = server.Fault
+			} else {
+				err = fmt.Errorf("nova: server %s went to ERROR while waiting for %s", serverID, status)
+			}
+			return
+		}
+
+		if time.Now().Add(pollInterval).After(deadline) {
+			err = fmt.Errorf("nova: timeout waiting for server %s to become %s, last status %s", serverID, status, server.Status)
+			return
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+func (o ListServersOpts) query() string {
+	query := url.Values{}
+	if o.Name != "" {
+		query.Set("name", o.Name)
+	}
+	if o.Status != "" {
+		query.Set("status", o.Status)
+	}
+	if o.Image != "" {
+		query.Set("image", o.Image)
+	}
+	if o.Flavor != "" {
+		query.Set("flavor", o.Flavor)
+	}
+	if o.Host != "" {
+		query.Set("host", o.Host)
+	}
+	if o.IP != "" {
+		query.Set("ip", o.IP)
+	}
+	if o.TenantID != "" {
+		query.Set("tenant_id", o.TenantID)
+	}
+	if o.ChangesSince != "" {
+		query.Set("changes-since", o.ChangesSince)
+	}
+	if o.AllTenants {
+		query.Set("all_tenants", "1")
+	}
+	if o.SortKey != "" {
+		query.Set("sort_key", o.SortKey)
+	}
+	if o.SortDir != "" {
+		query.Set("sort_dir", o.SortDir)
+	}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return query.Encode()
+}
@@ -0,0 +1,93 @@
+package nova
+
+import (
+	"strings"
+)
+
+const serverGroupsPath = "/os-server-groups"
+const serverGroupPath = "/os-server-groups/$id"
+
+const serverGroupRulesMicroversion = "compute 2.64" // single policy and rules were added in 2.64, only sent when rules are set
+const softAffinityMicroversion = "compute 2.15"     // soft-affinity and soft-anti-affinity were added in 2.15
+
+const (
+	// Affinity schedule all members on the same host
+	Affinity = "affinity"
+	// AntiAffinity schedule every member on a different host
+	AntiAffinity = "anti-affinity"
+	// SoftAffinity prefer the same host
+	SoftAffinity = "soft-affinity"
+	// SoftAntiAffinity prefer different hosts
+	SoftAntiAffinity = "soft-anti-affinity"
+)
+
+// ListServerGroups list the server groups of the project or of all projects
+func (n *nova) ListServerGroups(allProjects bool) (groups []ServerGroup, err error) {
+	path := n.endpoint() + serverGroupsPath
+	if allProjects {
+		path += "?all_projects=True"
+	}
+
+	var jsonResponse serverGroupsResponse
+	err = n.do("GET", path, "ListServerGroups", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	groups = jsonResponse.ServerGroups
+	return
+}
+
+// GetServerGroup get server group by id
+func (n *nova) GetServerGroup(groupID string) (group ServerGroup, err error) {
+	path := n.endpoint() + strings.Replace(serverGroupPath, "$id", groupID, -1)
+
+	var jsonResponse serverGroupResponse
+	err = n.do("GET", path, "GetServerGroup", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	group = jsonResponse.ServerGroup
+	return
+}
+
+// CreateServerGroup create a server group, pass its id as the group scheduler hint to boot members,
+// groups with rules require nova 2.64 (Rocky), groups without rules are created with the policies list and
+// soft policies require nova 2.15 (Mitaka)
+func (n *nova) CreateServerGroup(opts ServerGroupOpts) (group ServerGroup, err error) {
+	path := n.endpoint() + serverGroupsPath
+
+	body := interface{}(serverGroupRequest{ServerGroup: opts})
+	microversion := serverGroupRulesMicroversion
+	if opts.Rules == nil {
+		body = legacyServerGroupRequest{ServerGroup: legacyServerGroupOpts{Name: opts.Name, Policies: []string{opts.Policy}}}
+		microversion = ""
+		if opts.Policy == SoftAffinity || opts.Policy == SoftAntiAffinity {
+			microversion = softAffinityMicroversion
+		}
+	}
+
+	req, err := n.request("POST", path, "CreateServerGroup", body)
+	if err != nil {
+		return
+	}
+	if microversion != "" {
+		req.Header(apiVersionHeader, microversion)
+	}
+
+	var jsonResponse serverGroupResponse
+	_, err = n.exec(req, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	group = jsonResponse.ServerGroup
+	return
+}
+
+// DeleteServerGroup delete server group by id, members are not deleted
+func (n *nova) DeleteServerGroup(groupID string) (err error) {
+	path := n.endpoint() + strings.Replace(serverGroupPath, "$id", groupID, -1)
+	return n.do("DELETE", path, "DeleteServerGroup", nil, nil)
+}
@@ -0,0 +1,90 @@
+package nova
+
+import (
+	"net/url"
+	"strings"
+)
+
+const servicesPath = "/os-services"
+const servicePath = "/os-services/$id"
+const availabilityZonesPath = "/os-availability-zone"
+const availabilityZonesDetailPath = "/os-availability-zone/detail"
+
+// ListServices list compute services, host and binary e.g. nova-compute are optional filters
+func (n *nova) ListServices(host, binary string) (services []Service, err error) {
+	path := n.endpoint() + servicesPath
+	query := url.Values{}
+	if host != "" {
+		query.Set("host", host)
+	}
+	if binary != "" {
+		query.Set("binary", binary)
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	req, err := n.request("GET", path, "ListServices", nil)
+	if err != nil {
+		return
+	}
+
+	var jsonResponse servicesResponse
+	_, err = n.exec(req.Header(apiVersionHeader, uuidMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	services = jsonResponse.Services
+	return
+}
+
+// EnableService allow scheduling to the service again
+func (n *nova) EnableService(serviceID string) (service Service, err error) {
+	return n.updateService(serviceID, "EnableService", serviceRequest{Status: "enabled"})
+}
+
+// DisableService stop scheduling new servers to the service, reason is optional
+func (n *nova) DisableService(serviceID, reason string) (service Service, err error) {
+	return n.updateService(serviceID, "DisableService", serviceRequest{Status: "disabled", DisabledReason: reason})
+}
+
+// ForceDownService mark the service down without waiting for missed heartbeats, used for host evacuation
+func (n *nova) ForceDownService(serviceID string, forcedDown bool) (service Service, err error) {
+	return n.updateService(serviceID, "ForceDownService", serviceRequest{ForcedDown: &forcedDown})
+}
+
+func (n *nova) updateService(serviceID, operation string, in serviceRequest) (service Service, err error) {
+	path := n.endpoint() + strings.Replace(servicePath, "$id", serviceID, -1)
+
+	req, err := n.request("PUT", path, operation, in)
+	if err != nil {
+		return
+	}
+
+	var jsonResponse serviceResponse
+	_, err = n.exec(req.Header(apiVersionHeader, uuidMicroversion), &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	service = jsonResponse.Service
+	return
+}
+
+// ListAvailabilityZones list the availability zones, detail adds the hosts and their services and needs admin
+func (n *nova) ListAvailabilityZones(detail bool) (zones []AvailabilityZone, err error) {
+	path := n.endpoint() + availabilityZonesPath
+	if detail {
+		path = n.endpoint() + availabilityZonesDetailPath
+	}
+
+	var jsonResponse availabilityZonesResponse
+	err = n.do("GET", path, "ListAvailabilityZones", nil, &jsonResponse)
+	if err != nil {
+		return
+	}
+
+	zones = jsonResponse.AvailabilityZones
+	return
+}
@@ -0,0 +1,100 @@
+package nova
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Buni/openstack-client/openstack/client"
+)
+
+const tenantUsagesPath = "/os-simple-tenant-usage"
+const tenantUsagePath = "/os-simple-tenant-usage/$id"
+
+const usageMicroversion = "compute 2.40" // usage pagination was added in 2.40
+
+const usageTimeFormat = "2006-01-02T15:04:05.000000"
+
+// ListTenantUsage usage of all projects between opts.Start and opts.End, the pages are merged per project
+func (n *nova) ListTenantUsage(opts UsageOpts) (usages []TenantUsage, err error) {
+	path := n.endpoint() + tenantUsagesPath + "?" + opts.query()
+
+	index := map[string]int{}
+	for path != "" {
+		var req *client.Request
+		req, err = n.request("GET", path, "ListTenantUsage", nil)
+		if err != nil {
+			return
+		}
+
+		var jsonResponse tenantUsagesResponse
+		_, err = n.exec(req.Header(apiVersionHeader, usageMicroversion), &jsonResponse)
+		if err != nil {
+			return
+		}
+
+		for _, usage := range jsonResponse.TenantUsages {
+			if i, ok := index[usage.TenantID]; ok {
+				usages[i].merge(usage)
+				continue
+			}
+			index[usage.TenantID] = len(usages)
+			usages = append(usages, usage)
+		}
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// GetTenantUsage usage of a single project between opts.Start and opts.End, server usages are always included
+func (n *nova) GetTenantUsage(projectID string, opts UsageOpts) (usage TenantUsage, err error) {
+	path := n.endpoint() + strings.Replace(tenantUsagePath, "$id", projectID, -1) + "?" + opts.query()
+
+	first := true
+	for path != "" {
+		var req *client.Request
+		req, err = n.request("GET", path, "GetTenantUsage", nil)
+		if err != nil {
+			return
+		}
+
+		var jsonResponse tenantUsageResponse
+		_, err = n.exec(req.Header(apiVersionHeader, usageMicroversion), &jsonResponse)
+		if err != nil {
+			return
+		}
+
+		if first {
+			usage = jsonResponse.TenantUsage
+			first = false
+		} else {
+			usage.merge(jsonResponse.TenantUsage)
+		}
+		path = jsonResponse.Links.next()
+	}
+
+	return
+}
+
+// merge add the totals and server usages of another page of the same project
+func (u *TenantUsage) merge(page TenantUsage) {
+	u.TotalHours += page.TotalHours
+	u.TotalLocalGBUsage += page.TotalLocalGBUsage
+	u.TotalMemoryMBUsage += page.TotalMemoryMBUsage
+	u.TotalVCPUsUsage += page.TotalVCPUsUsage
+	u.ServerUsages = append(u.ServerUsages, page.ServerUsages...)
+}
+
+func (o UsageOpts) query() string {
+	query := url.Values{}
+	query.Set("start", o.Start.UTC().Format(usageTimeFormat))
+	query.Set("end", o.End.UTC().Format(usageTimeFormat))
+	if o.Detailed {
+		query.Set("detailed", "1")
+	}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return query.Encode()
+}
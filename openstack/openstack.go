@@ -4,6 +4,8 @@ import (
 	"github.com/Buni/openstack-client/openstack/cinder"
 	"github.com/Buni/openstack-client/openstack/client"
 	"github.com/Buni/openstack-client/openstack/keystone"
+	"github.com/Buni/openstack-client/openstack/neutron"
+	"github.com/Buni/openstack-client/openstack/nova"
 )
 
 // AuthOptions fields
@@ -26,6 +28,9 @@ type Openstack interface {
 	Authenticate() error
 	Keystone() keystone.Keystone
 	Cinder() cinder.Cinder
+	Nova() nova.Nova
+	Neutron() neutron.Neutron
+	ForProject(projectID string) (Openstack, error)
 }
 
 // NewClient instance of Authenticated client
@@ -33,6 +38,12 @@ func NewClient(ao AuthOptions) Openstack {
 	keystn := keystone.New(ao.Methods, ao.TenantName, ao.Domain, ao.Password, ao.Endpoint)
 	return &openstack{client: keystn.GetClient(), keystone: keystn}
 }
+
+// NewClientFromToken instance of a client authenticating with an existing e.g. unscoped or federated token
+func NewClientFromToken(token, projectID, endpoint string) Openstack {
+	keystn := keystone.NewFromToken(token, projectID, endpoint)
+	return &openstack{client: keystn.GetClient(), keystone: keystn}
+}
 func (o *openstack) Authenticate() error {
 	return o.keystone.Authenticate()
 }
@@ -42,6 +53,49 @@ func (o *openstack) Cinder() cinder.Cinder {
 	return cinder.New(o.client)
 }
 
+// Nova interface exposes all nova methods
+func (o *openstack) Nova() nova.Nova {
+	return nova.New(o.client)
+}
+
+// Neutron interface exposes all neutron methods
+func (o *openstack) Neutron() neutron.Neutron {
+	return neutron.New(o.client)
+}
+
+// NewClientApplicationCredential instance of a client authenticating with an application credential
+func NewClientApplicationCredential(credentialID, secret, endpoint string) Openstack {
+	keystn := keystone.NewApplicationCredential(credentialID, secret, endpoint)
+	return &openstack{client: keystn.GetClient(), keystone: keystn}
+}
+
+// NewClientEC2 instance of a client authenticating through /ec2tokens with an EC2 access and secret key
+func NewClientEC2(access, secret, endpoint string) Openstack {
+	keystn := keystone.NewEC2(access, secret, endpoint)
+	return &openstack{client: keystn.GetClient(), keystone: keystn}
+}
+
+// NewClientTrust instance of a client for the trustee acting on behalf of the trustor through trustID
+func NewClientTrust(name, dom, pass, trustID, endpoint string) Openstack {
+	keystn := keystone.NewTrust(name, dom, pass, trustID, endpoint)
+	return &openstack{client: keystn.GetClient(), keystone: keystn}
+}
+
+// NewClientOIDC instance of a client authenticating through a federated OpenID Connect identity provider
+func NewClientOIDC(opts keystone.OIDCOptions, projectID, endpoint string) Openstack {
+	keystn := keystone.NewOIDC(opts, projectID, endpoint)
+	return &openstack{client: keystn.GetClient(), keystone: keystn}
+}
+
+// ForProject rescope the current token to projectID and return an authenticated client for that project
+func (o *openstack) ForProject(projectID string) (Openstack, error) {
+	keystn, err := o.keystone.Rescope(projectID)
+	if err != nil {
+		return nil, err
+	}
+	return &openstack{client: keystn.GetClient(), keystone: keystn}, nil
+}
+
 func (o *openstack) Keystone() keystone.Keystone {
 	return o.keystone
 }